@@ -0,0 +1,247 @@
+package batch
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// DefaultConcurrency is the number of operations run at once when
+// Opts.Concurrency is not set.
+const DefaultConcurrency = 10
+
+// Mode determines how a batch reacts to a failed operation.
+type Mode int
+
+const (
+	// ContinueOnError runs every operation regardless of earlier failures.
+	ContinueOnError Mode = iota
+
+	// StopOnFirstError stops starting new operations once one has failed.
+	// Operations already in flight are allowed to finish; the rest are
+	// reported as skipped.
+	StopOnFirstError
+)
+
+// Opts configures how a batch is run.
+type Opts struct {
+	// Concurrency is the maximum number of operations in flight. Defaults to
+	// DefaultConcurrency.
+	Concurrency int
+
+	// Mode determines whether the batch stops after the first failure.
+	Mode Mode
+
+	// RateLimits caps the number of operations started per second for each
+	// service type, keyed by ServiceClient.Type (e.g. "network", "compute").
+	// Service types without an entry are not throttled.
+	RateLimits map[string]float64
+}
+
+// Operation is a single unit of work run by Run.
+type Operation struct {
+	// Key identifies the operation in the Report, typically the ID or name of
+	// the resource it acts upon.
+	Key string
+
+	// Client is the service client passed to Do. Its Type selects the rate
+	// limit applied to the operation.
+	Client *gophercloud.ServiceClient
+
+	// Do performs the operation.
+	Do func(ctx context.Context, client *gophercloud.ServiceClient) error
+}
+
+// Result is the outcome of a single operation.
+type Result struct {
+	// Index is the position of the operation in the input.
+	Index int
+
+	// Key is the key of the operation.
+	Key string
+
+	// Err is the error returned by the operation. It is nil if the operation
+	// succeeded or was skipped.
+	Err error
+
+	// Skipped is true if the operation was never started, either because an
+	// earlier operation failed in StopOnFirstError mode or because the context
+	// was cancelled.
+	Skipped bool
+}
+
+// Report holds the results of a batch, in the same order as its input.
+type Report struct {
+	Results []Result
+}
+
+// Succeeded returns the results of operations that completed without error.
+func (r Report) Succeeded() []Result {
+	return r.filter(func(res Result) bool { return !res.Skipped && res.Err == nil })
+}
+
+// Failed returns the results of operations that returned an error.
+func (r Report) Failed() []Result {
+	return r.filter(func(res Result) bool { return res.Err != nil })
+}
+
+// Skipped returns the results of operations that were never started.
+func (r Report) Skipped() []Result {
+	return r.filter(func(res Result) bool { return res.Skipped })
+}
+
+// Err returns an ErrBatch if any operation failed or was skipped, and nil
+// otherwise.
+func (r Report) Err() error {
+	failed, skipped := r.Failed(), r.Skipped()
+	if len(failed) == 0 && len(skipped) == 0 {
+		return nil
+	}
+	return ErrBatch{Total: len(r.Results), Failed: failed, Skipped: len(skipped)}
+}
+
+func (r Report) filter(keep func(Result) bool) []Result {
+	var s []Result
+	for _, res := range r.Results {
+		if keep(res) {
+			s = append(s, res)
+		}
+	}
+	return s
+}
+
+// ErrBatch is returned by Report.Err when some operations of a batch did not
+// succeed. The individual errors can be inspected with errors.As and
+// errors.Is.
+type ErrBatch struct {
+	gophercloud.BaseError
+	Total   int
+	Failed  []Result
+	Skipped int
+}
+
+func (e ErrBatch) Error() string {
+	s := fmt.Sprintf("%d of %d operations failed", len(e.Failed), e.Total)
+	if e.Skipped > 0 {
+		s += fmt.Sprintf(", %d skipped", e.Skipped)
+	}
+	if len(e.Failed) > 0 {
+		s += fmt.Sprintf(": first error for %s: %v", e.Failed[0].Key, e.Failed[0].Err)
+	}
+	return s
+}
+
+// Unwrap returns the errors of the failed operations.
+func (e ErrBatch) Unwrap() []error {
+	errs := make([]error, len(e.Failed))
+	for i, res := range e.Failed {
+		errs[i] = res.Err
+	}
+	return errs
+}
+
+// Run executes ops concurrently according to opts and returns a Report with
+// one Result per operation.
+//
+// Operations run concurrently, so their ProviderClient must use a token lock,
+// as enabled by ProviderClient.UseTokenLock. Clients created with
+// openstack.NewClient or openstack.AuthenticatedClient already do. Run does
+// not enable it itself, since that would race with other users of the client.
+func Run(ctx context.Context, ops []Operation, opts Opts) Report {
+	return run(ctx, ops, opts, newLimiters(opts.RateLimits))
+}
+
+// run is Run with the rate limiters supplied by the caller, so that nested
+// runs share the limits of the run they are part of.
+func run(ctx context.Context, ops []Operation, opts Opts, limiters limiters) Report {
+	keys := make([]string, len(ops))
+	for i, op := range ops {
+		keys[i] = op.Key
+	}
+	report := newReport(keys)
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	var (
+		mu      sync.Mutex
+		stopped bool
+		wg      sync.WaitGroup
+	)
+
+	indexes := make(chan int)
+	for w := 0; w < concurrency && w < len(ops); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				op := ops[i]
+
+				if err := limiters.wait(ctx, op.Client); err != nil {
+					continue
+				}
+
+				mu.Lock()
+				skip := stopped
+				mu.Unlock()
+				if skip {
+					continue
+				}
+
+				err := op.Do(ctx, op.Client)
+
+				mu.Lock()
+				report.Results[i].Skipped = false
+				report.Results[i].Err = err
+				if err != nil && opts.Mode == StopOnFirstError {
+					stopped = true
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+feed:
+	for i := range ops {
+		mu.Lock()
+		stop := stopped
+		mu.Unlock()
+		if stop {
+			break
+		}
+
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	return report
+}
+
+// ForEach runs fn for every item concurrently, using client for all of them,
+// and returns a Report keyed by the string form of each item.
+func ForEach[T any](ctx context.Context, client *gophercloud.ServiceClient, items []T, fn func(context.Context, *gophercloud.ServiceClient, T) error, opts Opts) Report {
+	return Run(ctx, forEachOps(client, items, fn), opts)
+}
+
+func forEachOps[T any](client *gophercloud.ServiceClient, items []T, fn func(context.Context, *gophercloud.ServiceClient, T) error) []Operation {
+	ops := make([]Operation, len(items))
+	for i, item := range items {
+		ops[i] = Operation{
+			Key:    fmt.Sprint(item),
+			Client: client,
+			Do: func(ctx context.Context, client *gophercloud.ServiceClient) error {
+				return fn(ctx, client, item)
+			},
+		}
+	}
+	return ops
+}
@@ -0,0 +1,260 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/objectstorage/v1/containers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/objectstorage/v1/objects"
+)
+
+// MaxBulkDeleteObjects is the number of objects sent in a single Swift bulk
+// delete request. It matches Swift's default max_deletes_per_request.
+const MaxBulkDeleteObjects = 10000
+
+// MaxBulkCreatePorts is the number of ports sent in a single Neutron bulk
+// create request.
+const MaxBulkCreatePorts = 100
+
+// ErrBulkDelete is reported for an object that Swift failed to delete as part
+// of a bulk delete request.
+type ErrBulkDelete struct {
+	gophercloud.BaseError
+	Object string
+	Status string
+}
+
+func (e ErrBulkDelete) Error() string {
+	return fmt.Sprintf("Unable to delete object %s: %s", e.Object, e.Status)
+}
+
+// DeleteObjects deletes the named objects from container.
+//
+// Objects are deleted with objects.BulkDelete, MaxBulkDeleteObjects at a
+// time. If the cluster does not have the bulk middleware enabled, each object
+// is deleted individually instead. Objects which do not exist are reported as
+// deleted.
+func DeleteObjects(ctx context.Context, client *gophercloud.ServiceClient, container string, names []string, opts Opts) Report {
+	report := newReport(names)
+	limiters := newLimiters(opts.RateLimits)
+
+	var chunks []Operation
+	for start := 0; start < len(names); start += MaxBulkDeleteObjects {
+		end := min(start+MaxBulkDeleteObjects, len(names))
+		chunks = append(chunks, Operation{
+			Key:    fmt.Sprintf("%s[%d:%d]", container, start, end),
+			Client: client,
+			Do: func(ctx context.Context, client *gophercloud.ServiceClient) error {
+				return deleteObjectChunk(ctx, client, container, names[start:end], report.Results[start:end], opts, limiters)
+			},
+		})
+	}
+
+	run(ctx, chunks, opts, limiters)
+	return report
+}
+
+func deleteObjectChunk(ctx context.Context, client *gophercloud.ServiceClient, container string, names []string, results []Result, opts Opts, limiters limiters) error {
+	resp, err := objects.BulkDelete(ctx, client, container, names).Extract()
+	unsupported, err := bulkDeleteUnsupported(ctx, client, container, err)
+	if unsupported {
+		// Chunks already run concurrently, so the objects of a chunk are
+		// deleted one at a time to stay within opts.Concurrency, and share
+		// the rate limiters of DeleteObjects to stay within opts.RateLimits.
+		inner := opts
+		inner.Concurrency = 1
+		sub := run(ctx, forEachOps(client, names, func(ctx context.Context, client *gophercloud.ServiceClient, name string) error {
+			err := objects.Delete(ctx, client, container, name, nil).Err
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return nil
+			}
+			return err
+		}), inner, limiters)
+		for i, res := range sub.Results {
+			results[i].Err, results[i].Skipped = res.Err, res.Skipped
+		}
+		return sub.Err()
+	}
+	if err != nil {
+		setErr(results, err)
+		return err
+	}
+
+	failed := make(map[string]string, len(resp.Errors))
+	for _, e := range resp.Errors {
+		if len(e) < 2 {
+			continue
+		}
+		p, err := url.PathUnescape(e[0])
+		if err != nil {
+			p = e[0]
+		}
+		failed[strings.TrimPrefix(strings.TrimPrefix(p, "/"), container+"/")] = e[1]
+	}
+
+	// A failed request without per-object errors, e.g. too many objects.
+	if len(failed) == 0 && resp.ResponseStatus != "" && !strings.HasPrefix(resp.ResponseStatus, "2") {
+		err := ErrBulkDelete{Object: container, Status: strings.TrimSpace(resp.ResponseStatus + " " + resp.ResponseBody)}
+		setErr(results, err)
+		return err
+	}
+
+	var first error
+	for i, name := range names {
+		results[i].Skipped = false
+		if status, ok := failed[name]; ok {
+			results[i].Err = ErrBulkDelete{Object: name, Status: status}
+			if first == nil {
+				first = results[i].Err
+			}
+		}
+	}
+	return first
+}
+
+// bulkDeleteUnsupported reports whether err, returned by a bulk delete of
+// objects in container, indicates that the bulk middleware is not enabled.
+// Without it, the bulk delete request is handled as an account POST (204) or
+// rejected outright.
+//
+// A 404 is only taken to mean that the middleware is missing if container
+// exists; otherwise the error of the container lookup is returned instead of
+// err.
+func bulkDeleteUnsupported(ctx context.Context, client *gophercloud.ServiceClient, container string, err error) (bool, error) {
+	var codeErr gophercloud.ErrUnexpectedResponseCode
+	if !errors.As(err, &codeErr) {
+		return false, err
+	}
+	switch codeErr.Actual {
+	case http.StatusNoContent, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return true, err
+	case http.StatusNotFound:
+		if cerr := containers.Get(ctx, client, container, nil).Err; cerr != nil {
+			return false, cerr
+		}
+		return true, err
+	}
+	return false, err
+}
+
+// CreateSecGroupRules creates the given security group rules.
+//
+// Neutron only accepts bulk rule creation for rules of a single security
+// group, so the rules are grouped by SecGroupID and each group is created with
+// one rules.CreateBulk call. A bulk request is atomic: if it fails, every rule
+// of the group is reported with the same error.
+//
+// The returned slice is aligned with opts; entries whose creation did not
+// succeed are nil.
+func CreateSecGroupRules(ctx context.Context, client *gophercloud.ServiceClient, opts []rules.CreateOpts, bopts Opts) ([]*rules.SecGroupRule, Report) {
+	keys := make([]string, len(opts))
+	groups := make(map[string][]int)
+	var order []string
+	for i, o := range opts {
+		keys[i] = fmt.Sprintf("%s/%d", o.SecGroupID, i)
+		if _, ok := groups[o.SecGroupID]; !ok {
+			order = append(order, o.SecGroupID)
+		}
+		groups[o.SecGroupID] = append(groups[o.SecGroupID], i)
+	}
+
+	report := newReport(keys)
+	created := make([]*rules.SecGroupRule, len(opts))
+
+	ops := make([]Operation, len(order))
+	for n, groupID := range order {
+		indexes := groups[groupID]
+		ops[n] = Operation{
+			Key:    groupID,
+			Client: client,
+			Do: func(ctx context.Context, client *gophercloud.ServiceClient) error {
+				groupOpts := make([]rules.CreateOpts, len(indexes))
+				for j, i := range indexes {
+					groupOpts[j] = opts[i]
+				}
+
+				rs, err := rules.CreateBulk(ctx, client, groupOpts).Extract()
+				if err == nil && len(rs) != len(indexes) {
+					err = fmt.Errorf("expected %d security group rules, got %d", len(indexes), len(rs))
+				}
+				for j, i := range indexes {
+					report.Results[i].Skipped = false
+					report.Results[i].Err = err
+					if err == nil {
+						created[i] = &rs[j]
+					}
+				}
+				return err
+			},
+		}
+	}
+
+	Run(ctx, ops, bopts)
+	return created, report
+}
+
+// CreatePorts creates the given ports with ports.CreateBulk,
+// MaxBulkCreatePorts at a time, sending the chunks concurrently.
+//
+// A bulk request is atomic: if it fails, every port of the chunk is reported
+// with the same error.
+//
+// The returned slice is aligned with opts; entries whose creation did not
+// succeed are nil.
+func CreatePorts(ctx context.Context, client *gophercloud.ServiceClient, opts []ports.CreateOptsBuilder, bopts Opts) ([]*ports.Port, Report) {
+	keys := make([]string, len(opts))
+	for i := range opts {
+		keys[i] = fmt.Sprint(i)
+	}
+
+	report := newReport(keys)
+	created := make([]*ports.Port, len(opts))
+
+	var chunks []Operation
+	for start := 0; start < len(opts); start += MaxBulkCreatePorts {
+		end := min(start+MaxBulkCreatePorts, len(opts))
+		chunks = append(chunks, Operation{
+			Key:    fmt.Sprintf("ports[%d:%d]", start, end),
+			Client: client,
+			Do: func(ctx context.Context, client *gophercloud.ServiceClient) error {
+				ps, err := ports.CreateBulk(ctx, client, opts[start:end]).Extract()
+				if err == nil && len(ps) != end-start {
+					err = fmt.Errorf("expected %d ports, got %d", end-start, len(ps))
+				}
+				setErr(report.Results[start:end], err)
+				if err != nil {
+					return err
+				}
+				for j := range ps {
+					created[start+j] = &ps[j]
+				}
+				return nil
+			},
+		})
+	}
+
+	Run(ctx, chunks, bopts)
+	return created, report
+}
+
+func newReport(keys []string) Report {
+	report := Report{Results: make([]Result, len(keys))}
+	for i, key := range keys {
+		report.Results[i] = Result{Index: i, Key: key, Skipped: true}
+	}
+	return report
+}
+
+func setErr(results []Result, err error) {
+	for i := range results {
+		results[i].Skipped = false
+		results[i].Err = err
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Package batch runs many ServiceClient operations concurrently and reports the
+outcome of each one.
+
+Operations are started by a bounded pool of workers and may be throttled per
+service type. The ProviderClient of the operations must use a token lock, as
+enabled by ProviderClient.UseTokenLock and by openstack.NewClient, so that an
+expired token is refreshed once rather than once per operation.
+
+Example to Delete Many Ports
+
+	report := batch.ForEach(context.TODO(), networkClient, portIDs, func(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+		return ports.Delete(ctx, client, id).ExtractErr()
+	}, batch.Opts{
+		Concurrency: 20,
+		RateLimits:  map[string]float64{"network": 50},
+	})
+
+	for _, r := range report.Failed() {
+		fmt.Printf("%s: %v\n", r.Key, r.Err)
+	}
+
+Example to Delete Objects Using the Bulk Middleware
+
+	report := batch.DeleteObjects(context.TODO(), objectClient, "my_container", names, batch.Opts{})
+	if err := report.Err(); err != nil {
+		panic(err)
+	}
+
+Example to Create Security Group Rules
+
+	created, report := batch.CreateSecGroupRules(context.TODO(), networkClient, ruleOpts, batch.Opts{
+		Mode: batch.StopOnFirstError,
+	})
+	if err := report.Err(); err != nil {
+		panic(err)
+	}
+
+	for _, rule := range created {
+		fmt.Printf("%+v\n", rule)
+	}
+*/
+package batch
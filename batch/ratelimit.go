@@ -0,0 +1,67 @@
+package batch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// limiter spaces operations evenly so that no more than a given number are
+// started per second.
+type limiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func (l *limiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	at := l.next
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	d := time.Until(at)
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// limiters holds one limiter per service type.
+type limiters map[string]*limiter
+
+func newLimiters(rates map[string]float64) limiters {
+	l := make(limiters, len(rates))
+	for serviceType, rate := range rates {
+		if rate > 0 {
+			l[serviceType] = &limiter{interval: time.Duration(float64(time.Second) / rate)}
+		}
+	}
+	return l
+}
+
+// wait blocks until the rate limit of the client's service type allows
+// another operation to start, or until ctx is done.
+func (l limiters) wait(ctx context.Context, client *gophercloud.ServiceClient) error {
+	if client != nil {
+		if lim, ok := l[client.Type]; ok {
+			return lim.wait(ctx)
+		}
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,2 @@
+// batch unit tests
+package testing
@@ -0,0 +1,275 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/batch"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/ports"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func handleDeletePorts(t *testing.T) {
+	th.Mux.HandleFunc("/ports/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		if strings.HasSuffix(r.URL.Path, "/missing") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func deletePort(ctx context.Context, client *gophercloud.ServiceClient, id string) error {
+	resp, err := client.Delete(ctx, client.ServiceURL("ports", id), nil)
+	_, _, err = gophercloud.ParseResponse(resp, err)
+	return err
+}
+
+func TestForEachContinueOnError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleDeletePorts(t)
+
+	client := fake.ServiceClient()
+	ids := []string{"a", "missing", "b", "c"}
+
+	report := batch.ForEach(context.TODO(), client, ids, deletePort, batch.Opts{Concurrency: 2})
+
+	th.AssertEquals(t, 4, len(report.Results))
+	th.AssertEquals(t, 3, len(report.Succeeded()))
+	th.AssertEquals(t, 0, len(report.Skipped()))
+
+	failed := report.Failed()
+	th.AssertEquals(t, 1, len(failed))
+	th.AssertEquals(t, "missing", failed[0].Key)
+	th.AssertEquals(t, 1, failed[0].Index)
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(failed[0].Err, http.StatusNotFound))
+
+	err := report.Err()
+	var batchErr batch.ErrBatch
+	th.AssertEquals(t, true, errors.As(err, &batchErr))
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusNotFound))
+}
+
+func TestForEachStopOnFirstError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleDeletePorts(t)
+
+	ids := []string{"a", "missing", "b", "c"}
+
+	report := batch.ForEach(context.TODO(), fake.ServiceClient(), ids, deletePort, batch.Opts{
+		Concurrency: 1,
+		Mode:        batch.StopOnFirstError,
+	})
+
+	th.AssertEquals(t, 1, len(report.Succeeded()))
+	th.AssertEquals(t, 1, len(report.Failed()))
+	th.AssertEquals(t, 2, len(report.Skipped()))
+	th.AssertEquals(t, "b", report.Skipped()[0].Key)
+}
+
+func TestDeleteObjects(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"bulk-delete": "true"})
+		th.TestBody(t, r, "testContainer/obj1\ntestContainer/obj%202\ntestContainer/obj3\n")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+    "Number Not Found": 0,
+    "Response Status": "400 Bad Request",
+    "Errors": [["/testContainer/obj%202", "403 Forbidden"]],
+    "Number Deleted": 2,
+    "Response Body": ""
+}`)
+	})
+
+	report := batch.DeleteObjects(context.TODO(), fake.ServiceClient(), "testContainer", []string{"obj1", "obj 2", "obj3"}, batch.Opts{})
+
+	th.AssertEquals(t, 2, len(report.Succeeded()))
+	failed := report.Failed()
+	th.AssertEquals(t, 1, len(failed))
+	th.AssertEquals(t, "obj 2", failed[0].Key)
+	th.AssertDeepEquals(t, batch.ErrBulkDelete{Object: "obj 2", Status: "403 Forbidden"}, failed[0].Err)
+}
+
+func TestDeleteObjectsWithoutBulkMiddleware(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "DELETE" && r.URL.Path == "/testContainer/obj1":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "DELETE" && r.URL.Path == "/testContainer/obj2":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	report := batch.DeleteObjects(context.TODO(), fake.ServiceClient(), "testContainer", []string{"obj1", "obj2"}, batch.Opts{})
+	th.AssertNoErr(t, report.Err())
+	th.AssertEquals(t, 2, len(report.Succeeded()))
+}
+
+func TestDeleteObjectsBulkNotFound(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == "HEAD" && r.URL.Path == "/testContainer":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "DELETE" && r.URL.Path == "/testContainer/obj1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	report := batch.DeleteObjects(context.TODO(), fake.ServiceClient(), "testContainer", []string{"obj1"}, batch.Opts{})
+	th.AssertNoErr(t, report.Err())
+	th.AssertEquals(t, 1, len(report.Succeeded()))
+}
+
+func TestDeleteObjectsMissingContainer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == "HEAD" && r.URL.Path == "/testContainer":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	report := batch.DeleteObjects(context.TODO(), fake.ServiceClient(), "testContainer", []string{"obj1", "obj2"}, batch.Opts{})
+	failed := report.Failed()
+	th.AssertEquals(t, 2, len(failed))
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(failed[0].Err, http.StatusNotFound))
+}
+
+func TestCreateSecGroupRules(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/security-group-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		b, err := io.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+
+		w.Header().Add("Content-Type", "application/json")
+		if strings.Contains(string(b), "bad-group") {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `
+{
+    "security_group_rules": [
+        {"id": "rule-1", "security_group_id": "good-group", "port_range_min": 22},
+        {"id": "rule-2", "security_group_id": "good-group", "port_range_min": 443}
+    ]
+}`)
+	})
+
+	opts := []rules.CreateOpts{
+		{Direction: rules.DirIngress, EtherType: rules.EtherType4, SecGroupID: "good-group", PortRangeMin: 22, PortRangeMax: 22, Protocol: rules.ProtocolTCP},
+		{Direction: rules.DirIngress, EtherType: rules.EtherType4, SecGroupID: "bad-group", PortRangeMin: 80, PortRangeMax: 80, Protocol: rules.ProtocolTCP},
+		{Direction: rules.DirIngress, EtherType: rules.EtherType4, SecGroupID: "good-group", PortRangeMin: 443, PortRangeMax: 443, Protocol: rules.ProtocolTCP},
+	}
+
+	created, report := batch.CreateSecGroupRules(context.TODO(), fake.ServiceClient(), opts, batch.Opts{})
+
+	th.AssertEquals(t, 3, len(created))
+	th.AssertEquals(t, "rule-1", created[0].ID)
+	th.AssertEquals(t, true, created[1] == nil)
+	th.AssertEquals(t, "rule-2", created[2].ID)
+
+	th.AssertEquals(t, 2, len(report.Succeeded()))
+	failed := report.Failed()
+	th.AssertEquals(t, 1, len(failed))
+	th.AssertEquals(t, 1, failed[0].Index)
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(failed[0].Err, http.StatusConflict))
+}
+
+func TestCreatePorts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var requests int
+	th.Mux.HandleFunc("/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		requests++
+
+		th.TestJSONRequest(t, r, `
+{
+    "ports": [
+        {"network_id": "net", "name": "port-0"},
+        {"network_id": "net", "name": "port-1"}
+    ]
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `
+{
+    "ports": [
+        {"id": "id-0", "network_id": "net", "name": "port-0"},
+        {"id": "id-1", "network_id": "net", "name": "port-1"}
+    ]
+}`)
+	})
+
+	opts := []ports.CreateOptsBuilder{
+		ports.CreateOpts{NetworkID: "net", Name: "port-0"},
+		ports.CreateOpts{NetworkID: "net", Name: "port-1"},
+	}
+
+	created, report := batch.CreatePorts(context.TODO(), fake.ServiceClient(), opts, batch.Opts{})
+	th.AssertNoErr(t, report.Err())
+	th.AssertEquals(t, 1, requests)
+	th.AssertEquals(t, 2, len(created))
+	th.AssertEquals(t, "id-0", created[0].ID)
+	th.AssertEquals(t, "id-1", created[1].ID)
+}
@@ -0,0 +1,54 @@
+/*
+Package policy takes scheduled backups of Block Storage volumes, chaining full
+and incremental backups, and prunes the backups which expired.
+
+Each run takes one backup of every selected volume: a full backup when the
+volume has none, or when its latest full backup is too old or already has
+enough incremental backups depending on it, and an incremental backup
+otherwise. Once the backup is available, the expired backups of the volume
+are deleted, unless more recent backups depend on them.
+
+Example to Back Up Volumes Nightly
+
+	listOpts := volumes.ListOpts{
+		Metadata: map[string]string{"backup": "nightly"},
+	}
+
+	opts := policy.Opts{
+		ListOpts:        listOpts,
+		MaxIncrementals: 6,
+		FullInterval:    7 * 24 * time.Hour,
+		Retention: policy.Retention{
+			Count:  14,
+			MaxAge: 30 * 24 * time.Hour,
+		},
+		Name:        "nightly",
+		Concurrency: 8,
+	}
+
+	report, err := policy.Run(context.TODO(), client, opts)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, o := range report.Outcomes {
+		fmt.Printf("%s: %s %s backup %s, pruned %v\n", o.VolumeID, o.Status, o.Kind, o.BackupID, o.Pruned)
+	}
+
+	if err := report.Err(); err != nil {
+		panic(err)
+	}
+
+Example to Save a Report
+
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+
+	err = os.WriteFile("backup-report.json", b, 0o644)
+	if err != nil {
+		panic(err)
+	}
+*/
+package policy
@@ -0,0 +1,387 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/batch"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/backups"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/volumes"
+)
+
+const (
+	// DefaultConcurrency is the number of volumes backed up at once when
+	// Opts.Concurrency is not set.
+	DefaultConcurrency = 4
+
+	// DefaultPollInterval is the time between two checks of a backup being
+	// created or deleted when Opts.PollInterval is not set.
+	DefaultPollInterval = 10 * time.Second
+)
+
+// Retention selects the backups of a volume to prune once a new backup was
+// taken. A backup expires when it is not among the Count most recent backups
+// of its volume, or when it is older than MaxAge. Expired backups which more
+// recent incremental backups depend on are retained until their whole chain
+// expires.
+type Retention struct {
+	// Count is the number of most recent backups kept. Zero disables
+	// pruning by count.
+	Count int
+
+	// MaxAge is the age beyond which backups expire. Zero disables pruning
+	// by age.
+	MaxAge time.Duration
+}
+
+// Opts configures Run.
+type Opts struct {
+	// VolumeIDs are the IDs of the volumes to back up.
+	VolumeIDs []string
+
+	// ListOpts selects the volumes to back up when VolumeIDs is empty.
+	ListOpts volumes.ListOptsBuilder
+
+	// AllTenants lists the backups of all projects, which is required to
+	// back up volumes of other projects.
+	AllTenants bool
+
+	// MaxIncrementals is the number of incremental backups taken after a
+	// full backup before the next full one. Zero places no limit.
+	MaxIncrementals int
+
+	// FullInterval is the age of the latest full backup beyond which a new
+	// full backup is taken. Zero places no limit.
+	FullInterval time.Duration
+
+	// Retention selects the backups pruned once a volume was backed up.
+	Retention Retention
+
+	// Name, Description, Container and Metadata are passed to
+	// backups.Create.
+	Name        string
+	Description string
+	Container   string
+	Metadata    map[string]string
+
+	// Concurrency is the number of volumes backed up at once. Defaults to
+	// DefaultConcurrency.
+	Concurrency int
+
+	// PollInterval is the time between two checks of a backup being created
+	// or deleted. Defaults to DefaultPollInterval.
+	PollInterval time.Duration
+
+	// OnOutcome is called once each volume is handled. It may be called
+	// concurrently.
+	OnOutcome func(Outcome)
+}
+
+type runner struct {
+	client   *gophercloud.ServiceClient
+	opts     Opts
+	interval time.Duration
+	now      time.Time
+}
+
+// Run backs up the selected volumes and prunes their expired backups.
+//
+// For each volume, Run takes a full backup if the volume has none, if
+// MaxIncrementals incremental backups were taken since the latest full one,
+// or if the latest full backup is older than FullInterval. It takes an
+// incremental backup otherwise. Volumes in use are backed up with Force.
+// Run waits for the backup to be available, and then deletes the expired
+// backups of the volume, most recent first, never deleting a backup which
+// another one depends on.
+//
+// The returned error is only set if the volumes or their backups could not
+// be listed; the outcome of each volume is recorded in the Report.
+func Run(ctx context.Context, client *gophercloud.ServiceClient, opts Opts) (*Report, error) {
+	report := &Report{}
+
+	var vols []volumes.Volume
+	if len(opts.VolumeIDs) > 0 {
+		for _, id := range opts.VolumeIDs {
+			vols = append(vols, volumes.Volume{ID: id})
+		}
+	} else {
+		allPages, err := volumes.List(client, opts.ListOpts).AllPages(ctx)
+		if err != nil {
+			return report, err
+		}
+		vols, err = volumes.ExtractVolumes(allPages)
+		if err != nil {
+			return report, err
+		}
+	}
+
+	existing, err := listBackups(ctx, client, opts.AllTenants)
+	if err != nil {
+		return report, err
+	}
+
+	r := &runner{
+		client:   client,
+		opts:     opts,
+		interval: opts.PollInterval,
+		now:      time.Now(),
+	}
+	if r.interval <= 0 {
+		r.interval = DefaultPollInterval
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	outcomes := make([]Outcome, len(vols))
+	ops := make([]batch.Operation, len(vols))
+	for i := range vols {
+		outcomes[i] = Outcome{VolumeID: vols[i].ID, VolumeName: vols[i].Name}
+		ops[i] = batch.Operation{
+			Key:    vols[i].ID,
+			Client: client,
+			Do: func(ctx context.Context, _ *gophercloud.ServiceClient) error {
+				r.backUp(ctx, &vols[i], existing[vols[i].ID], &outcomes[i])
+				if opts.OnOutcome != nil {
+					opts.OnOutcome(outcomes[i])
+				}
+				return outcomes[i].Err
+			},
+		}
+	}
+	results := batch.Run(ctx, ops, batch.Opts{Concurrency: concurrency})
+	for i, res := range results.Results {
+		if res.Skipped {
+			outcomes[i].Status, outcomes[i].Err = StatusSkipped, ctx.Err()
+		}
+	}
+	report.Outcomes = outcomes
+
+	return report, nil
+}
+
+// listBackups returns the backups of every volume, oldest first.
+func listBackups(ctx context.Context, client *gophercloud.ServiceClient, allTenants bool) (map[string][]backups.Backup, error) {
+	allPages, err := backups.ListDetail(client, backups.ListDetailOpts{AllTenants: allTenants}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	all, err := backups.ExtractBackups(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	byVolume := make(map[string][]backups.Backup)
+	for _, b := range all {
+		byVolume[b.VolumeID] = append(byVolume[b.VolumeID], b)
+	}
+	for _, s := range byVolume {
+		sortBackups(s)
+	}
+	return byVolume, nil
+}
+
+func sortBackups(s []backups.Backup) {
+	slices.SortStableFunc(s, func(a, b backups.Backup) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+}
+
+// backUp backs up a single volume, prunes its backups and records the
+// outcome in o.
+func (r *runner) backUp(ctx context.Context, v *volumes.Volume, existing []backups.Backup, o *Outcome) {
+	if v.Status == "" {
+		current, err := volumes.Get(ctx, r.client, v.ID).Extract()
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			o.Status, o.Err = StatusSkipped, err
+			return
+		}
+		if err != nil {
+			o.Status, o.Err = StatusFailed, err
+			return
+		}
+		*v = *current
+		o.VolumeName = v.Name
+	}
+
+	if v.Status != "available" && v.Status != "in-use" {
+		o.Status, o.Err = StatusSkipped, fmt.Errorf("volume %s is %s and cannot be backed up", v.ID, v.Status)
+		return
+	}
+
+	var available []backups.Backup
+	for _, b := range existing {
+		switch b.Status {
+		case "available":
+			available = append(available, b)
+		case "creating", "deleting", "restoring":
+			o.Status, o.Err = StatusSkipped, fmt.Errorf("backup %s of volume %s is %s", b.ID, v.ID, b.Status)
+			return
+		}
+	}
+
+	o.Kind, o.Reason = r.decide(available)
+	createOpts := backups.CreateOpts{
+		VolumeID:    v.ID,
+		Force:       v.Status == "in-use",
+		Incremental: o.Kind == KindIncremental,
+		Name:        r.opts.Name,
+		Description: r.opts.Description,
+		Container:   r.opts.Container,
+		Metadata:    r.opts.Metadata,
+	}
+	created, err := backups.Create(ctx, r.client, createOpts).Extract()
+	if err != nil {
+		o.Status, o.Err = StatusFailed, err
+		return
+	}
+	o.BackupID = created.ID
+
+	created, err = r.waitAvailable(ctx, created.ID)
+	if err != nil {
+		o.Status, o.Err = StatusFailed, err
+		return
+	}
+
+	prune, retain := r.expired(append(available, *created))
+	o.Retained = retain
+	for _, b := range prune {
+		if err := r.delete(ctx, b.ID); err != nil {
+			o.Status, o.Err = StatusFailed, err
+			return
+		}
+		o.Pruned = append(o.Pruned, b.ID)
+	}
+
+	o.Status = StatusSucceeded
+}
+
+// decide returns the kind of the next backup of a volume given its available
+// backups, oldest first, and the reason for a full backup.
+func (r *runner) decide(available []backups.Backup) (Kind, string) {
+	chains := chainsOf(available)
+	if len(chains) == 0 {
+		return KindFull, "no previous backup"
+	}
+
+	last := chains[len(chains)-1]
+	if n := len(last) - 1; r.opts.MaxIncrementals > 0 && n >= r.opts.MaxIncrementals {
+		return KindFull, fmt.Sprintf("%d incremental backups since the last full backup", n)
+	}
+	if r.opts.FullInterval > 0 && r.now.Sub(last[0].CreatedAt) >= r.opts.FullInterval {
+		return KindFull, fmt.Sprintf("last full backup is older than %s", r.opts.FullInterval)
+	}
+	return KindIncremental, ""
+}
+
+// chainsOf splits backups, oldest first, into chains made of a full backup
+// and the incremental backups which depend on it.
+func chainsOf(s []backups.Backup) [][]backups.Backup {
+	var chains [][]backups.Backup
+	for _, b := range s {
+		if !b.IsIncremental || len(chains) == 0 {
+			chains = append(chains, nil)
+		}
+		chains[len(chains)-1] = append(chains[len(chains)-1], b)
+	}
+	return chains
+}
+
+// expired returns the expired backups of a volume which can be deleted, most
+// recent first, and the IDs of the ones which must be retained because other
+// backups depend on them. available holds the backups of the volume, oldest
+// first.
+func (r *runner) expired(available []backups.Backup) ([]backups.Backup, []string) {
+	retention := r.opts.Retention
+	isExpired := func(rank int, b backups.Backup) bool {
+		return (retention.Count > 0 && rank >= retention.Count) ||
+			(retention.MaxAge > 0 && r.now.Sub(b.CreatedAt) > retention.MaxAge)
+	}
+
+	var prune []backups.Backup
+	var retain []string
+	rank := 0
+	chains := chainsOf(available)
+	for i := len(chains) - 1; i >= 0; i-- {
+		chain := chains[i]
+
+		// Walk the chain from its most recent backup: once a backup is
+		// kept, the older ones it depends on are kept too. The most recent
+		// backup of a chain may have dependents which are not available
+		// yet, e.g. being created.
+		kept := false
+		for j := len(chain) - 1; j >= 0; j-- {
+			b := chain[j]
+			switch {
+			case !isExpired(rank, b):
+				kept = true
+			case kept || (j == len(chain)-1 && b.HasDependentBackups):
+				kept = true
+				retain = append(retain, b.ID)
+			default:
+				prune = append(prune, b)
+			}
+			rank++
+		}
+	}
+	return prune, retain
+}
+
+// waitAvailable waits until a backup is created.
+func (r *runner) waitAvailable(ctx context.Context, id string) (*backups.Backup, error) {
+	for {
+		b, err := backups.Get(ctx, r.client, id).Extract()
+		if err != nil {
+			return nil, err
+		}
+		switch b.Status {
+		case "available":
+			return b, nil
+		case "error":
+			return nil, fmt.Errorf("backup %s failed: %s", id, b.FailReason)
+		}
+
+		if err := r.sleep(ctx); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// delete deletes a backup and waits until it is gone, so that the backups it
+// depends on can be deleted next.
+func (r *runner) delete(ctx context.Context, id string) error {
+	if err := backups.Delete(ctx, r.client, id).ExtractErr(); err != nil {
+		return err
+	}
+	for {
+		b, err := backups.Get(ctx, r.client, id).Extract()
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if b.Status == "error_deleting" {
+			return fmt.Errorf("backup %s could not be deleted: %s", id, b.FailReason)
+		}
+
+		if err := r.sleep(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+func (r *runner) sleep(ctx context.Context) error {
+	select {
+	case <-time.After(r.interval):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
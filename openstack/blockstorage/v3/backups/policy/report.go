@@ -0,0 +1,171 @@
+package policy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// Kind is the kind of backup taken of a volume.
+type Kind string
+
+const (
+	// KindFull is a backup holding the whole volume.
+	KindFull Kind = "full"
+
+	// KindIncremental is a backup holding the changes since the previous
+	// backup of the volume.
+	KindIncremental Kind = "incremental"
+)
+
+// Status is the outcome of backing up a single volume.
+type Status string
+
+const (
+	// StatusSucceeded is reported for volumes which were backed up and
+	// whose expired backups were pruned.
+	StatusSucceeded Status = "succeeded"
+
+	// StatusFailed is reported for volumes which could not be backed up,
+	// or whose expired backups could not be pruned.
+	StatusFailed Status = "failed"
+
+	// StatusSkipped is reported for volumes which were not backed up,
+	// either because their status does not allow it or because the run was
+	// interrupted before their turn.
+	StatusSkipped Status = "skipped"
+)
+
+// Outcome is the result of backing up a single volume.
+type Outcome struct {
+	VolumeID   string
+	VolumeName string
+	Status     Status
+
+	// Kind is the kind of backup taken, and Reason the reason a full backup
+	// was taken.
+	Kind   Kind
+	Reason string
+
+	// BackupID is the ID of the backup taken.
+	BackupID string
+
+	// Pruned are the IDs of the expired backups which were deleted.
+	Pruned []string
+
+	// Retained are the IDs of the expired backups which were kept because
+	// more recent incremental backups depend on them.
+	Retained []string
+
+	Err error
+}
+
+type jsonOutcome struct {
+	VolumeID   string   `json:"volume_id"`
+	VolumeName string   `json:"volume_name,omitempty"`
+	Status     Status   `json:"status"`
+	Kind       Kind     `json:"kind,omitempty"`
+	Reason     string   `json:"reason,omitempty"`
+	BackupID   string   `json:"backup_id,omitempty"`
+	Pruned     []string `json:"pruned,omitempty"`
+	Retained   []string `json:"retained,omitempty"`
+	Err        string   `json:"error,omitempty"`
+}
+
+// MarshalJSON encodes the outcome, including the message of its error.
+func (o Outcome) MarshalJSON() ([]byte, error) {
+	j := jsonOutcome{
+		VolumeID:   o.VolumeID,
+		VolumeName: o.VolumeName,
+		Status:     o.Status,
+		Kind:       o.Kind,
+		Reason:     o.Reason,
+		BackupID:   o.BackupID,
+		Pruned:     o.Pruned,
+		Retained:   o.Retained,
+	}
+	if o.Err != nil {
+		j.Err = o.Err.Error()
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes an outcome encoded by MarshalJSON.
+func (o *Outcome) UnmarshalJSON(b []byte) error {
+	var j jsonOutcome
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	*o = Outcome{
+		VolumeID:   j.VolumeID,
+		VolumeName: j.VolumeName,
+		Status:     j.Status,
+		Kind:       j.Kind,
+		Reason:     j.Reason,
+		BackupID:   j.BackupID,
+		Pruned:     j.Pruned,
+		Retained:   j.Retained,
+	}
+	if j.Err != "" {
+		o.Err = errors.New(j.Err)
+	}
+	return nil
+}
+
+// Report holds the outcome of every volume of a run.
+type Report struct {
+	Outcomes []Outcome `json:"outcomes"`
+}
+
+// Failed returns the outcomes of volumes which could not be backed up or
+// pruned.
+func (r Report) Failed() []Outcome {
+	return r.filter(StatusFailed)
+}
+
+// Skipped returns the outcomes of volumes which were not backed up.
+func (r Report) Skipped() []Outcome {
+	return r.filter(StatusSkipped)
+}
+
+func (r Report) filter(status Status) []Outcome {
+	var s []Outcome
+	for _, o := range r.Outcomes {
+		if o.Status == status {
+			s = append(s, o)
+		}
+	}
+	return s
+}
+
+// Err returns an ErrBackup if a volume was not backed up or pruned, and nil
+// otherwise.
+func (r Report) Err() error {
+	failed, skipped := r.Failed(), r.Skipped()
+	if len(failed) == 0 && len(skipped) == 0 {
+		return nil
+	}
+	return ErrBackup{Total: len(r.Outcomes), Failed: failed, Skipped: len(skipped)}
+}
+
+// ErrBackup is returned by Report.Err when some volumes were not backed up
+// or pruned.
+type ErrBackup struct {
+	gophercloud.BaseError
+	Total   int
+	Failed  []Outcome
+	Skipped int
+}
+
+func (e ErrBackup) Error() string {
+	s := fmt.Sprintf("%d of %d volumes failed", len(e.Failed), e.Total)
+	if e.Skipped > 0 {
+		s += fmt.Sprintf(", %d skipped", e.Skipped)
+	}
+	if len(e.Failed) > 0 {
+		s += fmt.Sprintf(": first error for %s: %v", e.Failed[0].VolumeID, e.Failed[0].Err)
+	}
+	return s
+}
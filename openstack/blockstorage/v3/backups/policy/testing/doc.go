@@ -0,0 +1,2 @@
+// Package testing includes backup policy unit tests
+package testing
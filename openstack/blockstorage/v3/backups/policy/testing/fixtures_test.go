@@ -0,0 +1,244 @@
+package testing
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const timeFormat = "2006-01-02T15:04:05.000000"
+
+// fakeVolume is a volume of a fakeCloud.
+type fakeVolume struct {
+	ID     string
+	Name   string
+	Status string
+}
+
+// fakeBackup is a backup of a fakeCloud. A new backup is "creating" on its
+// first Get, and then "available" or "error" if FailReason is set.
+type fakeBackup struct {
+	ID            string
+	VolumeID      string
+	Status        string
+	IsIncremental bool
+	CreatedAt     time.Time
+	FailReason    string
+
+	deleted bool
+}
+
+// fakeCloud simulates the Block Storage API of volumes being backed up.
+type fakeCloud struct {
+	t       *testing.T
+	mu      sync.Mutex
+	volumes []*fakeVolume
+	backups []*fakeBackup
+	actions []string
+
+	// FailReason is set on the backups created by the next requests.
+	FailReason string
+}
+
+// Ago returns the time d ago.
+func Ago(d time.Duration) time.Time {
+	return time.Now().UTC().Add(-d)
+}
+
+// hasDependents tells whether a backup is the base of a later incremental
+// backup of its volume.
+func (c *fakeCloud) hasDependents(b *fakeBackup) bool {
+	var next *fakeBackup
+	for _, o := range c.backups {
+		if o.VolumeID == b.VolumeID && !o.deleted && o.CreatedAt.After(b.CreatedAt) && (next == nil || o.CreatedAt.Before(next.CreatedAt)) {
+			next = o
+		}
+	}
+	return next != nil && next.IsIncremental
+}
+
+func (c *fakeCloud) backup(id string) *fakeBackup {
+	for _, b := range c.backups {
+		if b.ID == id && !b.deleted {
+			return b
+		}
+	}
+	return nil
+}
+
+func (c *fakeCloud) backupJSON(b *fakeBackup) map[string]any {
+	return map[string]any{
+		"id":                    b.ID,
+		"volume_id":             b.VolumeID,
+		"status":                b.Status,
+		"is_incremental":        b.IsIncremental,
+		"has_dependent_backups": c.hasDependents(b),
+		"created_at":            b.CreatedAt.Format(timeFormat),
+		"fail_reason":           b.FailReason,
+	}
+}
+
+func volumeJSON(v *fakeVolume) map[string]any {
+	return map[string]any{"id": v.ID, "name": v.Name, "status": v.Status}
+}
+
+// Actions returns the actions taken on the cloud, in order.
+func (c *fakeCloud) Actions() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.actions...)
+}
+
+// Backups returns the IDs of the backups left on the cloud.
+func (c *fakeCloud) Backups() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var ids []string
+	for _, b := range c.backups {
+		if !b.deleted {
+			ids = append(ids, b.ID)
+		}
+	}
+	return ids
+}
+
+func reply(t *testing.T, w http.ResponseWriter, code int, body any) {
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(code)
+	th.AssertNoErr(t, json.NewEncoder(w).Encode(body))
+}
+
+// NewFakeCloud registers the handlers of a fake cloud holding the given
+// volumes and backups.
+func NewFakeCloud(t *testing.T, volumes []*fakeVolume, backups []*fakeBackup) *fakeCloud {
+	c := &fakeCloud{t: t, volumes: volumes, backups: backups}
+
+	th.Mux.HandleFunc("/volumes/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		id := strings.TrimPrefix(r.URL.Path, "/volumes/")
+		if id == "detail" {
+			var vols []map[string]any
+			for _, v := range c.volumes {
+				vols = append(vols, volumeJSON(v))
+			}
+			reply(t, w, http.StatusOK, map[string]any{"volumes": vols})
+			return
+		}
+		for _, v := range c.volumes {
+			if v.ID == id {
+				reply(t, w, http.StatusOK, map[string]any{"volume": volumeJSON(v)})
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	th.Mux.HandleFunc("/backups/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		bs := []map[string]any{}
+		for _, b := range c.backups {
+			if !b.deleted {
+				bs = append(bs, c.backupJSON(b))
+			}
+		}
+		reply(t, w, http.StatusOK, map[string]any{"backups": bs})
+	})
+
+	th.Mux.HandleFunc("/backups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		var body struct {
+			Backup struct {
+				VolumeID    string `json:"volume_id"`
+				Force       bool   `json:"force"`
+				Incremental bool   `json:"incremental"`
+				Name        string `json:"name"`
+			} `json:"backup"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		action := fmt.Sprintf("create %s", body.Backup.VolumeID)
+		if body.Backup.Incremental {
+			action += " incremental"
+		}
+		if body.Backup.Force {
+			action += " force"
+		}
+		c.actions = append(c.actions, action)
+
+		b := &fakeBackup{
+			ID:            fmt.Sprintf("new-%s", body.Backup.VolumeID),
+			VolumeID:      body.Backup.VolumeID,
+			Status:        "new",
+			IsIncremental: body.Backup.Incremental,
+			CreatedAt:     time.Now().UTC(),
+			FailReason:    c.FailReason,
+		}
+		c.backups = append(c.backups, b)
+		reply(t, w, http.StatusAccepted, map[string]any{"backup": map[string]any{"id": b.ID, "name": body.Backup.Name}})
+	})
+
+	th.Mux.HandleFunc("/backups/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		b := c.backup(strings.TrimPrefix(r.URL.Path, "/backups/"))
+		if b == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case "GET":
+			switch {
+			case b.Status == "new":
+				b.Status = "creating"
+			case b.Status == "creating" && b.FailReason != "":
+				b.Status = "error"
+			case b.Status == "creating":
+				b.Status = "available"
+			case b.Status == "deleting":
+				b.deleted = true
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			reply(t, w, http.StatusOK, map[string]any{"backup": c.backupJSON(b)})
+		case "DELETE":
+			if c.hasDependents(b) {
+				t.Errorf("backup %s deleted before its dependents", b.ID)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			c.actions = append(c.actions, "delete "+b.ID)
+			b.Status = "deleting"
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	return c
+}
@@ -0,0 +1,214 @@
+package testing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/backups/policy"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const day = 24 * time.Hour
+
+func TestRun(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	cloud := NewFakeCloud(t, []*fakeVolume{
+		{ID: "vol-new", Name: "new", Status: "available"},
+		{ID: "vol-db", Name: "db", Status: "in-use"},
+		{ID: "vol-broken", Name: "broken", Status: "error"},
+	}, []*fakeBackup{
+		{ID: "db-full", VolumeID: "vol-db", Status: "available", CreatedAt: Ago(2 * day)},
+		{ID: "db-inc", VolumeID: "vol-db", Status: "available", IsIncremental: true, CreatedAt: Ago(day)},
+	})
+
+	var seen []string
+	opts := policy.Opts{
+		ListOpts:        volumes.ListOpts{},
+		MaxIncrementals: 3,
+		Concurrency:     1,
+		PollInterval:    time.Millisecond,
+		OnOutcome: func(o policy.Outcome) {
+			seen = append(seen, o.VolumeID)
+		},
+	}
+	report, err := policy.Run(context.TODO(), client.ServiceClient(), opts)
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, []string{
+		"create vol-new",
+		"create vol-db incremental force",
+	}, cloud.Actions())
+	th.AssertDeepEquals(t, []string{"vol-new", "vol-db", "vol-broken"}, seen)
+
+	th.AssertEquals(t, 3, len(report.Outcomes))
+	newVol := report.Outcomes[0]
+	th.AssertEquals(t, policy.StatusSucceeded, newVol.Status)
+	th.AssertEquals(t, policy.KindFull, newVol.Kind)
+	th.AssertEquals(t, "no previous backup", newVol.Reason)
+	th.AssertEquals(t, "new-vol-new", newVol.BackupID)
+
+	db := report.Outcomes[1]
+	th.AssertEquals(t, policy.StatusSucceeded, db.Status)
+	th.AssertEquals(t, policy.KindIncremental, db.Kind)
+	th.AssertEquals(t, "db", db.VolumeName)
+
+	broken := report.Outcomes[2]
+	th.AssertEquals(t, policy.StatusSkipped, broken.Status)
+
+	var errBackup policy.ErrBackup
+	th.AssertEquals(t, true, errors.As(report.Err(), &errBackup))
+	th.AssertEquals(t, 1, errBackup.Skipped)
+	th.AssertEquals(t, 0, len(errBackup.Failed))
+
+	// The report can be saved.
+	b, err := json.Marshal(report)
+	th.AssertNoErr(t, err)
+	var saved policy.Report
+	th.AssertNoErr(t, json.Unmarshal(b, &saved))
+	th.AssertEquals(t, broken.Err.Error(), saved.Outcomes[2].Err.Error())
+	th.AssertEquals(t, "new-vol-new", saved.Outcomes[0].BackupID)
+}
+
+// chain returns a full backup of vol-db and two incremental backups, taken
+// one day apart, the most recent one being taken a day ago.
+func chain() []*fakeBackup {
+	return []*fakeBackup{
+		{ID: "full", VolumeID: "vol-db", Status: "available", CreatedAt: Ago(3 * day)},
+		{ID: "inc-1", VolumeID: "vol-db", Status: "available", IsIncremental: true, CreatedAt: Ago(2 * day)},
+		{ID: "inc-2", VolumeID: "vol-db", Status: "available", IsIncremental: true, CreatedAt: Ago(day)},
+	}
+}
+
+func TestRunPruneByCount(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	cloud := NewFakeCloud(t, []*fakeVolume{{ID: "vol-db", Status: "available"}}, chain())
+
+	opts := policy.Opts{
+		VolumeIDs:       []string{"vol-db"},
+		MaxIncrementals: 2,
+		Retention:       policy.Retention{Count: 1},
+		PollInterval:    time.Millisecond,
+	}
+	report, err := policy.Run(context.TODO(), client.ServiceClient(), opts)
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, report.Err())
+
+	// A full backup starts a new chain, and the old chain is deleted from
+	// its most recent backup.
+	th.AssertDeepEquals(t, []string{
+		"create vol-db",
+		"delete inc-2",
+		"delete inc-1",
+		"delete full",
+	}, cloud.Actions())
+	th.AssertDeepEquals(t, []string{"new-vol-db"}, cloud.Backups())
+
+	o := report.Outcomes[0]
+	th.AssertEquals(t, policy.KindFull, o.Kind)
+	th.AssertEquals(t, "2 incremental backups since the last full backup", o.Reason)
+	th.AssertDeepEquals(t, []string{"inc-2", "inc-1", "full"}, o.Pruned)
+	th.AssertEquals(t, 0, len(o.Retained))
+}
+
+func TestRunRetainsDependencies(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	cloud := NewFakeCloud(t, []*fakeVolume{{ID: "vol-db", Status: "available"}}, chain())
+
+	opts := policy.Opts{
+		VolumeIDs:    []string{"vol-db"},
+		Retention:    policy.Retention{Count: 2},
+		PollInterval: time.Millisecond,
+	}
+	report, err := policy.Run(context.TODO(), client.ServiceClient(), opts)
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, report.Err())
+
+	// The new incremental backup and inc-2 are kept, and inc-2 depends on
+	// the older backups of its chain.
+	th.AssertDeepEquals(t, []string{"create vol-db incremental"}, cloud.Actions())
+
+	o := report.Outcomes[0]
+	th.AssertEquals(t, policy.KindIncremental, o.Kind)
+	th.AssertEquals(t, 0, len(o.Pruned))
+	th.AssertDeepEquals(t, []string{"inc-1", "full"}, o.Retained)
+}
+
+func TestRunPruneByAge(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	cloud := NewFakeCloud(t, []*fakeVolume{{ID: "vol-db", Status: "available"}}, chain())
+
+	opts := policy.Opts{
+		VolumeIDs:    []string{"vol-db"},
+		FullInterval: 2 * day,
+		Retention:    policy.Retention{MaxAge: 36 * time.Hour},
+		PollInterval: time.Millisecond,
+	}
+	report, err := policy.Run(context.TODO(), client.ServiceClient(), opts)
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, report.Err())
+
+	// Only inc-2 is recent enough, and it depends on the rest of its chain.
+	th.AssertDeepEquals(t, []string{"create vol-db"}, cloud.Actions())
+
+	o := report.Outcomes[0]
+	th.AssertEquals(t, policy.KindFull, o.Kind)
+	th.AssertEquals(t, "last full backup is older than 48h0m0s", o.Reason)
+	th.AssertDeepEquals(t, []string{"inc-1", "full"}, o.Retained)
+}
+
+func TestRunBackupFails(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	cloud := NewFakeCloud(t, []*fakeVolume{{ID: "vol-db", Status: "available"}}, chain())
+	cloud.FailReason = "backup service is down"
+
+	opts := policy.Opts{
+		VolumeIDs:    []string{"vol-db"},
+		Retention:    policy.Retention{Count: 1},
+		PollInterval: time.Millisecond,
+	}
+	report, err := policy.Run(context.TODO(), client.ServiceClient(), opts)
+	th.AssertNoErr(t, err)
+
+	// Nothing is pruned when the new backup failed.
+	th.AssertDeepEquals(t, []string{"create vol-db incremental"}, cloud.Actions())
+
+	o := report.Outcomes[0]
+	th.AssertEquals(t, policy.StatusFailed, o.Status)
+	th.AssertEquals(t, "backup new-vol-db failed: backup service is down", o.Err.Error())
+	th.AssertEquals(t, "1 of 1 volumes failed: first error for vol-db: backup new-vol-db failed: backup service is down", report.Err().Error())
+}
+
+func TestRunBackupInProgress(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	backups := chain()
+	backups[2].Status = "creating"
+	cloud := NewFakeCloud(t, []*fakeVolume{{ID: "vol-db", Status: "available"}}, backups)
+
+	opts := policy.Opts{
+		VolumeIDs:    []string{"vol-db"},
+		PollInterval: time.Millisecond,
+	}
+	report, err := policy.Run(context.TODO(), client.ServiceClient(), opts)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 0, len(cloud.Actions()))
+	th.AssertEquals(t, policy.StatusSkipped, report.Outcomes[0].Status)
+	th.AssertEquals(t, "backup inc-2 of volume vol-db is creating", report.Outcomes[0].Err.Error())
+}
@@ -157,6 +157,10 @@ type ListDetailOpts struct {
 	// AllTenants will retrieve backups of all tenants/projects.
 	AllTenants bool `q:"all_tenants"`
 
+	// TenantID will filter by a specific tenant/project ID.
+	// Setting AllTenants is required to use this.
+	TenantID string `q:"project_id"`
+
 	// Comma-separated list of sort keys and optional sort directions in the
 	// form of <key>[:<direction>].
 	Sort string `q:"sort"`
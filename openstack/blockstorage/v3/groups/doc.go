@@ -0,0 +1,77 @@
+/*
+Package groups provides information and interaction with generic volume
+groups in the OpenStack Block Storage service. A group brings volumes of one
+or more volume types together, so that they can be snapshotted and replicated
+consistently. Groups require microversion 3.13 or later.
+
+Example to list Groups
+
+	client.Microversion = "3.13"
+
+	allPages, err := groups.List(client, groups.ListOpts{}).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+	allGroups, err := groups.ExtractGroups(allPages)
+	if err != nil {
+		panic(err)
+	}
+	for _, group := range allGroups {
+		fmt.Println(group)
+	}
+
+Example to create a Group
+
+	createOpts := groups.CreateOpts{
+		Name:        "database",
+		GroupType:   "7270c56e-6354-4528-8e8b-f54dee2232c8",
+		VolumeTypes: []string{"ssd", "hdd"},
+	}
+	group, err := groups.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to create a Group from a Group Snapshot
+
+	client.Microversion = "3.14"
+
+	createOpts := groups.CreateFromSrcOpts{
+		Name:            "database-restored",
+		GroupSnapshotID: "a7e3bc55-1c9c-4e4c-8e58-b6d4b6cb4b4c",
+	}
+	group, err := groups.CreateFromSrc(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to add Volumes to a Group
+
+	updateOpts := groups.UpdateOpts{
+		AddVolumes: []string{
+			"289da7f8-6440-407c-9fb4-7db01ec49164",
+			"96c3bda7-c82a-4f50-be73-ca7621794835",
+		},
+	}
+	err := groups.Update(context.TODO(), client, groupID, updateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to delete a Group along with its Volumes
+
+	err := groups.Delete(context.TODO(), client, groupID, groups.DeleteOpts{DeleteVolumes: true}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to reset the status of a Group
+
+	client.Microversion = "3.20"
+
+	err := groups.ResetStatus(context.TODO(), client, groupID, groups.ResetStatusOpts{Status: "available"}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package groups
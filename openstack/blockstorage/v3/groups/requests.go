@@ -0,0 +1,314 @@
+package groups
+
+import (
+	"context"
+	"strings"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToGroupCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains options for creating a Group. This object is passed to
+// the groups.Create function. For more information about these parameters,
+// see the Group object.
+type CreateOpts struct {
+	// GroupType is the ID of the group type of the group.
+	GroupType string `json:"group_type" required:"true"`
+	// VolumeTypes are the IDs of the volume types the volumes of the group
+	// may have.
+	VolumeTypes      []string `json:"volume_types" required:"true"`
+	Name             string   `json:"name,omitempty"`
+	Description      string   `json:"description,omitempty"`
+	AvailabilityZone string   `json:"availability_zone,omitempty"`
+}
+
+// ToGroupCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToGroupCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "group")
+}
+
+// Create will create a new Group based on the values in CreateOpts. To
+// extract the Group object from the response, call the Extract method on the
+// CreateResult.
+func Create(ctx context.Context, client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateFromSrcOptsBuilder allows extensions to add additional parameters to
+// the CreateFromSrc request.
+type CreateFromSrcOptsBuilder interface {
+	ToGroupCreateFromSrcMap() (map[string]any, error)
+}
+
+// CreateFromSrcOpts contains options for creating a Group from a group
+// snapshot or from another group. Exactly one of GroupSnapshotID and
+// SourceGroupID must be set.
+type CreateFromSrcOpts struct {
+	GroupSnapshotID string `json:"group_snapshot_id,omitempty" xor:"SourceGroupID"`
+	SourceGroupID   string `json:"source_group_id,omitempty" xor:"GroupSnapshotID"`
+	Name            string `json:"name,omitempty"`
+	Description     string `json:"description,omitempty"`
+}
+
+// ToGroupCreateFromSrcMap assembles a request body based on the contents of a
+// CreateFromSrcOpts.
+func (opts CreateFromSrcOpts) ToGroupCreateFromSrcMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "create-from-src")
+}
+
+// CreateFromSrc will create a new Group, along with its volumes, from a group
+// snapshot or from another group. It requires microversion 3.14 or later. To
+// extract the Group object from the response, call the Extract method on the
+// CreateResult.
+func CreateFromSrc(ctx context.Context, client *gophercloud.ServiceClient, opts CreateFromSrcOptsBuilder) (r CreateResult) {
+	b, err := opts.ToGroupCreateFromSrcMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, createFromSrcURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteOptsBuilder allows extensions to add additional parameters to the
+// Delete request.
+type DeleteOptsBuilder interface {
+	ToGroupDeleteMap() (map[string]any, error)
+}
+
+// DeleteOpts contains options for deleting a Group.
+type DeleteOpts struct {
+	// DeleteVolumes deletes the volumes of the group along with it. A group
+	// with volumes cannot be deleted otherwise.
+	DeleteVolumes bool `json:"delete-volumes"`
+}
+
+// ToGroupDeleteMap assembles a request body based on the contents of a
+// DeleteOpts.
+func (opts DeleteOpts) ToGroupDeleteMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "delete")
+}
+
+// Delete will delete the existing Group with the provided ID. If opts is nil,
+// the volumes of the group are kept.
+func Delete(ctx context.Context, client *gophercloud.ServiceClient, id string, opts DeleteOptsBuilder) (r DeleteResult) {
+	b := map[string]any{"delete": map[string]any{}}
+	if opts != nil {
+		m, err := opts.ToGroupDeleteMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		b = m
+	}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetOptsBuilder allows extensions to add additional parameters to the Get
+// request.
+type GetOptsBuilder interface {
+	ToGroupGetQuery() (string, error)
+}
+
+// GetOpts holds options for getting a Group.
+type GetOpts struct {
+	// ListVolume includes the IDs of the volumes of the group. It requires
+	// microversion 3.25 or later.
+	ListVolume bool `q:"list_volume"`
+}
+
+// ToGroupGetQuery formats a GetOpts into a query string.
+func (opts GetOpts) ToGroupGetQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// Get retrieves the Group with the provided ID. To extract the Group object
+// from the response, call the Extract method on the GetResult.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, id string, opts GetOptsBuilder) (r GetResult) {
+	url := getURL(client, id)
+	if opts != nil {
+		query, err := opts.ToGroupGetQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	resp, err := client.Get(ctx, url, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToGroupListQuery() (string, error)
+}
+
+// ListOpts holds options for listing Groups. It is passed to the groups.List
+// function.
+type ListOpts struct {
+	// AllTenants will retrieve groups of all tenants/projects.
+	AllTenants bool `q:"all_tenants"`
+
+	// Name will filter by the specified group name.
+	Name string `q:"name"`
+
+	// Status will filter by the specified status.
+	Status string `q:"status"`
+
+	// TenantID will filter by a specific tenant/project ID.
+	// Setting AllTenants is required to use this.
+	TenantID string `q:"project_id"`
+
+	// Comma-separated list of sort keys and optional sort directions in the
+	// form of <key>[:<direction>].
+	Sort string `q:"sort"`
+
+	// Requests a page size of items.
+	Limit int `q:"limit"`
+
+	// Used in conjunction with limit to return a slice of items.
+	Offset int `q:"offset"`
+
+	// The ID of the last-seen item.
+	Marker string `q:"marker"`
+}
+
+// ToGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToGroupListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns Groups optionally limited by the conditions provided in
+// ListOpts.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return GroupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToGroupUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contain options for updating an existing Group. This object is
+// passed to the groups.Update function. For more information about the
+// parameters, see the Group object.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// AddVolumes are the IDs of the volumes to add to the group. Their
+	// volume type must be one of the volume types of the group.
+	AddVolumes []string `json:"-"`
+
+	// RemoveVolumes are the IDs of the volumes to remove from the group.
+	RemoveVolumes []string `json:"-"`
+}
+
+// ToGroupUpdateMap assembles a request body based on the contents of an
+// UpdateOpts.
+func (opts UpdateOpts) ToGroupUpdateMap() (map[string]any, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "group")
+	if err != nil {
+		return nil, err
+	}
+
+	// The volumes are given as comma-separated lists of IDs.
+	groupMap := b["group"].(map[string]any)
+	if len(opts.AddVolumes) > 0 {
+		groupMap["add_volumes"] = strings.Join(opts.AddVolumes, ",")
+	}
+	if len(opts.RemoveVolumes) > 0 {
+		groupMap["remove_volumes"] = strings.Join(opts.RemoveVolumes, ",")
+	}
+
+	return b, nil
+}
+
+// Update will update the Group with provided information, and add or remove
+// volumes. The update is asynchronous and Update returns no Group; call Get
+// to follow its progress.
+func Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, updateURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ResetStatusOptsBuilder allows extensions to add additional parameters to the
+// ResetStatus request.
+type ResetStatusOptsBuilder interface {
+	ToGroupResetStatusMap() (map[string]any, error)
+}
+
+// ResetStatusOpts contains options for resetting a Group status.
+type ResetStatusOpts struct {
+	// Status is a group status to reset to.
+	Status string `json:"status" required:"true"`
+}
+
+// ToGroupResetStatusMap assembles a request body based on the contents of a
+// ResetStatusOpts.
+func (opts ResetStatusOpts) ToGroupResetStatusMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "reset_status")
+}
+
+// ResetStatus will reset the existing group status. It requires microversion
+// 3.20 or later. ResetStatusResult contains only the error. To extract it,
+// call the ExtractErr method on the ResetStatusResult.
+func ResetStatus(ctx context.Context, client *gophercloud.ServiceClient, id string, opts ResetStatusOptsBuilder) (r ResetStatusResult) {
+	b, err := opts.ToGroupResetStatusMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
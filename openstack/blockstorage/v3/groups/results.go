@@ -0,0 +1,154 @@
+package groups
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// Group contains all the information associated with a generic volume group.
+type Group struct {
+	// Unique identifier.
+	ID string `json:"id"`
+
+	// Current status of the Group.
+	Status string `json:"status"`
+
+	// Display name.
+	Name string `json:"name"`
+
+	// Display description.
+	Description string `json:"description"`
+
+	// The availability zone of the Group.
+	AvailabilityZone string `json:"availability_zone"`
+
+	// The ID of the group type of the Group.
+	GroupType string `json:"group_type"`
+
+	// The IDs of the volume types the volumes of the Group may have.
+	VolumeTypes []string `json:"volume_types"`
+
+	// The IDs of the volumes of the Group. They are only returned by Get
+	// with GetOpts.ListVolume.
+	Volumes []string `json:"volumes"`
+
+	// The ID of the group snapshot the Group was created from.
+	GroupSnapshotID string `json:"group_snapshot_id"`
+
+	// The ID of the group the Group was created from.
+	SourceGroupID string `json:"source_group_id"`
+
+	// The replication status of the Group.
+	ReplicationStatus string `json:"replication_status"`
+
+	// The ID of the project owning the Group. It is returned as of
+	// microversion 3.58.
+	ProjectID string `json:"project_id"`
+
+	// Date created.
+	CreatedAt time.Time `json:"-"`
+}
+
+// UnmarshalJSON converts our JSON API response into our group struct
+func (r *Group) UnmarshalJSON(b []byte) error {
+	type tmp Group
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Group(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+
+	return err
+}
+
+// GroupPage is a pagination.Pager that is returned from a call to the List function.
+type GroupPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a GroupPage contains no Groups.
+func (r GroupPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	groups, err := ExtractGroups(r)
+	return len(groups) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r GroupPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"groups_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// ExtractGroups extracts and returns Groups. It is used while iterating over a groups.List call.
+func ExtractGroups(r pagination.Page) ([]Group, error) {
+	var s []Group
+	err := ExtractGroupsInto(r, &s)
+	return s, err
+}
+
+// ExtractGroupsInto similar to ExtractInto but operates on a `list` of groups
+func ExtractGroupsInto(r pagination.Page, v any) error {
+	return r.(GroupPage).Result.ExtractIntoSlicePtr(v, "groups")
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the Group object out of the commonResult object.
+func (r commonResult) Extract() (*Group, error) {
+	var s Group
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtractInto converts our response data into a group struct
+func (r commonResult) ExtractInto(v any) error {
+	return r.Result.ExtractIntoStructPtr(v, "group")
+}
+
+// CreateResult contains the response body and error from a Create or
+// CreateFromSrc request. Only the ID and name of the Group are returned.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult contains the response body and error from an Update request.
+// Call its ExtractErr method to determine if the request succeeded or failed.
+type UpdateResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteResult contains the response body and error from a Delete request.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ResetStatusResult contains the response error from a ResetStatus request.
+type ResetStatusResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,2 @@
+// Package testing includes groups unit tests
+package testing
@@ -0,0 +1,222 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/groups"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const GroupID = "6f519a48-3183-46cf-a32f-41815f813986"
+
+const ListResponse = `
+{
+    "groups": [
+        {
+            "id": "6f519a48-3183-46cf-a32f-41815f813986",
+            "status": "available",
+            "availability_zone": "az1",
+            "created_at": "2017-04-21T10:13:16.000000",
+            "name": "database",
+            "description": "",
+            "group_type": "7270c56e-6354-4528-8e8b-f54dee2232c8",
+            "volume_types": ["ssd", "hdd"],
+            "group_snapshot_id": null,
+            "source_group_id": null,
+            "replication_status": "disabled",
+            "project_id": "7ccf4863071f44aeb8f141f65780c51b"
+        },
+        {
+            "id": "aed36625-a6d7-4681-ba59-c7ba3d18c148",
+            "status": "creating",
+            "availability_zone": "az1",
+            "created_at": "2017-04-22T08:00:01.000000",
+            "name": "database-restored",
+            "description": "",
+            "group_type": "7270c56e-6354-4528-8e8b-f54dee2232c8",
+            "volume_types": ["ssd"],
+            "group_snapshot_id": "a7e3bc55-1c9c-4e4c-8e58-b6d4b6cb4b4c",
+            "source_group_id": null,
+            "replication_status": "disabled",
+            "project_id": "7ccf4863071f44aeb8f141f65780c51b"
+        }
+    ],
+    "groups_links": [
+        {
+            "href": "%s/groups/detail?marker=aed36625-a6d7-4681-ba59-c7ba3d18c148",
+            "rel": "next"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "group": {
+        "id": "6f519a48-3183-46cf-a32f-41815f813986",
+        "status": "available",
+        "availability_zone": "az1",
+        "created_at": "2017-04-21T10:13:16.000000",
+        "name": "database",
+        "description": "",
+        "group_type": "7270c56e-6354-4528-8e8b-f54dee2232c8",
+        "volume_types": ["ssd", "hdd"],
+        "volumes": [
+            "289da7f8-6440-407c-9fb4-7db01ec49164",
+            "96c3bda7-c82a-4f50-be73-ca7621794835"
+        ],
+        "group_snapshot_id": null,
+        "source_group_id": null,
+        "replication_status": "disabled",
+        "project_id": "7ccf4863071f44aeb8f141f65780c51b"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "group": {
+        "name": "database",
+        "group_type": "7270c56e-6354-4528-8e8b-f54dee2232c8",
+        "volume_types": ["ssd", "hdd"],
+        "availability_zone": "az1"
+    }
+}
+`
+
+const CreateResponse = `
+{
+    "group": {
+        "id": "6f519a48-3183-46cf-a32f-41815f813986",
+        "name": "database"
+    }
+}
+`
+
+const CreateFromSrcRequest = `
+{
+    "create-from-src": {
+        "name": "database-restored",
+        "group_snapshot_id": "a7e3bc55-1c9c-4e4c-8e58-b6d4b6cb4b4c"
+    }
+}
+`
+
+const CreateFromSrcResponse = `
+{
+    "group": {
+        "id": "aed36625-a6d7-4681-ba59-c7ba3d18c148",
+        "name": "database-restored"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "group": {
+        "name": "db",
+        "add_volumes": "289da7f8-6440-407c-9fb4-7db01ec49164,96c3bda7-c82a-4f50-be73-ca7621794835",
+        "remove_volumes": "b8bc8c54-3a35-4b8c-9ad3-0e6bdf3c8f5a"
+    }
+}
+`
+
+// Group is the first group of ListResponse, as returned by GetResponse.
+var Group = groups.Group{
+	ID:               GroupID,
+	Status:           "available",
+	AvailabilityZone: "az1",
+	CreatedAt:        time.Date(2017, 4, 21, 10, 13, 16, 0, time.UTC),
+	Name:             "database",
+	GroupType:        "7270c56e-6354-4528-8e8b-f54dee2232c8",
+	VolumeTypes:      []string{"ssd", "hdd"},
+	Volumes: []string{
+		"289da7f8-6440-407c-9fb4-7db01ec49164",
+		"96c3bda7-c82a-4f50-be73-ca7621794835",
+	},
+	ReplicationStatus: "disabled",
+	ProjectID:         "7ccf4863071f44aeb8f141f65780c51b",
+}
+
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			th.TestFormValues(t, r, map[string]string{"all_tenants": "true"})
+			fmt.Fprintf(w, ListResponse, th.Server.URL)
+		case "aed36625-a6d7-4681-ba59-c7ba3d18c148":
+			fmt.Fprint(w, `{"groups": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups/"+GroupID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"list_volume": "true"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, CreateResponse)
+	})
+}
+
+func HandleCreateFromSrcSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateFromSrcRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, CreateFromSrcResponse)
+	})
+}
+
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/groups/"+GroupID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleActionSuccessfully expects the given action on the group.
+func HandleActionSuccessfully(t *testing.T, request string) {
+	th.Mux.HandleFunc("/groups/"+GroupID+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, request)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
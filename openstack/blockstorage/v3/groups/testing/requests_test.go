@@ -0,0 +1,146 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/groups"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	pages := 0
+	err := groups.List(client.ServiceClient(), groups.ListOpts{AllTenants: true}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := groups.ExtractGroups(page)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, 2, len(actual))
+
+		expected := Group
+		expected.Volumes = nil
+		th.CheckDeepEquals(t, expected, actual[0])
+		th.AssertEquals(t, "creating", actual[1].Status)
+		th.AssertEquals(t, "a7e3bc55-1c9c-4e4c-8e58-b6d4b6cb4b4c", actual[1].GroupSnapshotID)
+		th.CheckDeepEquals(t, []string{"ssd"}, actual[1].VolumeTypes)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, pages)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := groups.Get(context.TODO(), client.ServiceClient(), GroupID, groups.GetOpts{ListVolume: true}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Group, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := groups.CreateOpts{
+		Name:             "database",
+		GroupType:        "7270c56e-6354-4528-8e8b-f54dee2232c8",
+		VolumeTypes:      []string{"ssd", "hdd"},
+		AvailabilityZone: "az1",
+	}
+	actual, err := groups.Create(context.TODO(), client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, GroupID, actual.ID)
+	th.AssertEquals(t, "database", actual.Name)
+}
+
+func TestCreateRequiresVolumeTypes(t *testing.T) {
+	createOpts := groups.CreateOpts{
+		Name:      "database",
+		GroupType: "7270c56e-6354-4528-8e8b-f54dee2232c8",
+	}
+	_, err := createOpts.ToGroupCreateMap()
+	if err == nil {
+		t.Fatal("expected an error for missing volume types")
+	}
+}
+
+func TestCreateFromSrc(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateFromSrcSuccessfully(t)
+
+	createOpts := groups.CreateFromSrcOpts{
+		Name:            "database-restored",
+		GroupSnapshotID: "a7e3bc55-1c9c-4e4c-8e58-b6d4b6cb4b4c",
+	}
+	actual, err := groups.CreateFromSrc(context.TODO(), client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "aed36625-a6d7-4681-ba59-c7ba3d18c148", actual.ID)
+}
+
+func TestCreateFromSrcRequiresOneSource(t *testing.T) {
+	for _, createOpts := range []groups.CreateFromSrcOpts{
+		{Name: "database-restored"},
+		{GroupSnapshotID: "a7e3bc55-1c9c-4e4c-8e58-b6d4b6cb4b4c", SourceGroupID: GroupID},
+	} {
+		_, err := createOpts.ToGroupCreateFromSrcMap()
+		if err == nil {
+			t.Fatalf("expected an error for %+v", createOpts)
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	name := "db"
+	updateOpts := groups.UpdateOpts{
+		Name: &name,
+		AddVolumes: []string{
+			"289da7f8-6440-407c-9fb4-7db01ec49164",
+			"96c3bda7-c82a-4f50-be73-ca7621794835",
+		},
+		RemoveVolumes: []string{"b8bc8c54-3a35-4b8c-9ad3-0e6bdf3c8f5a"},
+	}
+	err := groups.Update(context.TODO(), client.ServiceClient(), GroupID, updateOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, `{"delete": {"delete-volumes": true}}`)
+
+	err := groups.Delete(context.TODO(), client.ServiceClient(), GroupID, groups.DeleteOpts{DeleteVolumes: true}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDeleteWithoutOpts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, `{"delete": {}}`)
+
+	err := groups.Delete(context.TODO(), client.ServiceClient(), GroupID, nil).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestResetStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, `{"reset_status": {"status": "error"}}`)
+
+	err := groups.ResetStatus(context.TODO(), client.ServiceClient(), GroupID, groups.ResetStatusOpts{Status: "error"}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
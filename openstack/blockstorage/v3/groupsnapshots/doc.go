@@ -0,0 +1,53 @@
+/*
+Package groupsnapshots provides information and interaction with snapshots of
+generic volume groups in the OpenStack Block Storage service. A group snapshot
+holds a snapshot of every volume of a group, taken consistently when the group
+type enables it. Group snapshots require microversion 3.14 or later.
+
+Example to list Group Snapshots of a Group
+
+	client.Microversion = "3.14"
+
+	listOpts := groupsnapshots.ListOpts{
+		GroupID: "6f519a48-3183-46cf-a32f-41815f813986",
+	}
+	allPages, err := groupsnapshots.List(client, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+	allGroupSnapshots, err := groupsnapshots.ExtractGroupSnapshots(allPages)
+	if err != nil {
+		panic(err)
+	}
+	for _, groupSnapshot := range allGroupSnapshots {
+		fmt.Println(groupSnapshot)
+	}
+
+Example to create a Group Snapshot
+
+	createOpts := groupsnapshots.CreateOpts{
+		GroupID: "6f519a48-3183-46cf-a32f-41815f813986",
+		Name:    "database-nightly",
+	}
+	groupSnapshot, err := groupsnapshots.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to delete a Group Snapshot
+
+	err := groupsnapshots.Delete(context.TODO(), client, groupSnapshotID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to reset the status of a Group Snapshot
+
+	client.Microversion = "3.19"
+
+	err := groupsnapshots.ResetStatus(context.TODO(), client, groupSnapshotID, groupsnapshots.ResetStatusOpts{Status: "available"}).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package groupsnapshots
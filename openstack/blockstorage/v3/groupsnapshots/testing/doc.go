@@ -0,0 +1,2 @@
+// Package testing includes group snapshots unit tests
+package testing
@@ -0,0 +1,140 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/groupsnapshots"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const GroupSnapshotID = "a7e3bc55-1c9c-4e4c-8e58-b6d4b6cb4b4c"
+
+const ListResponse = `
+{
+    "group_snapshots": [
+        {
+            "id": "a7e3bc55-1c9c-4e4c-8e58-b6d4b6cb4b4c",
+            "group_id": "6f519a48-3183-46cf-a32f-41815f813986",
+            "status": "available",
+            "created_at": "2017-04-22T07:00:00.000000",
+            "name": "database-nightly",
+            "description": null,
+            "group_type_id": "7270c56e-6354-4528-8e8b-f54dee2232c8",
+            "project_id": "7ccf4863071f44aeb8f141f65780c51b"
+        },
+        {
+            "id": "d4c1f3cf-2a8b-4d3f-8d38-2b3d0ed7c2b5",
+            "group_id": "6f519a48-3183-46cf-a32f-41815f813986",
+            "status": "creating",
+            "created_at": "2017-04-23T07:00:00.000000",
+            "name": "database-nightly",
+            "description": null,
+            "group_type_id": "7270c56e-6354-4528-8e8b-f54dee2232c8",
+            "project_id": "7ccf4863071f44aeb8f141f65780c51b"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "group_snapshot": {
+        "id": "a7e3bc55-1c9c-4e4c-8e58-b6d4b6cb4b4c",
+        "group_id": "6f519a48-3183-46cf-a32f-41815f813986",
+        "status": "available",
+        "created_at": "2017-04-22T07:00:00.000000",
+        "name": "database-nightly",
+        "description": null,
+        "group_type_id": "7270c56e-6354-4528-8e8b-f54dee2232c8",
+        "project_id": "7ccf4863071f44aeb8f141f65780c51b"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "group_snapshot": {
+        "group_id": "6f519a48-3183-46cf-a32f-41815f813986",
+        "name": "database-nightly"
+    }
+}
+`
+
+const CreateResponse = `
+{
+    "group_snapshot": {
+        "id": "a7e3bc55-1c9c-4e4c-8e58-b6d4b6cb4b4c",
+        "name": "database-nightly",
+        "group_type_id": "7270c56e-6354-4528-8e8b-f54dee2232c8"
+    }
+}
+`
+
+// GroupSnapshot is the first group snapshot of ListResponse.
+var GroupSnapshot = groupsnapshots.GroupSnapshot{
+	ID:          GroupSnapshotID,
+	GroupID:     "6f519a48-3183-46cf-a32f-41815f813986",
+	Status:      "available",
+	CreatedAt:   time.Date(2017, 4, 22, 7, 0, 0, 0, time.UTC),
+	Name:        "database-nightly",
+	GroupTypeID: "7270c56e-6354-4528-8e8b-f54dee2232c8",
+	ProjectID:   "7ccf4863071f44aeb8f141f65780c51b",
+}
+
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/group_snapshots/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"group_id": "6f519a48-3183-46cf-a32f-41815f813986"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/group_snapshots/"+GroupSnapshotID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/group_snapshots", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, CreateResponse)
+	})
+}
+
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/group_snapshots/"+GroupSnapshotID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+func HandleResetStatusSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/group_snapshots/"+GroupSnapshotID+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"reset_status": {"status": "error"}}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -0,0 +1,69 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/groupsnapshots"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	listOpts := groupsnapshots.ListOpts{GroupID: "6f519a48-3183-46cf-a32f-41815f813986"}
+	allPages, err := groupsnapshots.List(client.ServiceClient(), listOpts).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := groupsnapshots.ExtractGroupSnapshots(allPages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(actual))
+	th.CheckDeepEquals(t, GroupSnapshot, actual[0])
+	th.AssertEquals(t, "creating", actual[1].Status)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := groupsnapshots.Get(context.TODO(), client.ServiceClient(), GroupSnapshotID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, GroupSnapshot, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := groupsnapshots.CreateOpts{
+		GroupID: "6f519a48-3183-46cf-a32f-41815f813986",
+		Name:    "database-nightly",
+	}
+	actual, err := groupsnapshots.Create(context.TODO(), client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, GroupSnapshotID, actual.ID)
+	th.AssertEquals(t, "7270c56e-6354-4528-8e8b-f54dee2232c8", actual.GroupTypeID)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := groupsnapshots.Delete(context.TODO(), client.ServiceClient(), GroupSnapshotID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestResetStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleResetStatusSuccessfully(t)
+
+	err := groupsnapshots.ResetStatus(context.TODO(), client.ServiceClient(), GroupSnapshotID, groupsnapshots.ResetStatusOpts{Status: "error"}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
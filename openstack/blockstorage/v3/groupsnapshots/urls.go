@@ -0,0 +1,23 @@
+package groupsnapshots
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("group_snapshots")
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("group_snapshots", "detail")
+}
+
+func getURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("group_snapshots", id)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, id string) string {
+	return getURL(c, id)
+}
+
+func actionURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("group_snapshots", id, "action")
+}
@@ -0,0 +1,89 @@
+/*
+Package grouptypes provides information and interaction with group types in the
+OpenStack Block Storage service. A group type is a collection of specs used to
+define the capabilities of generic volume groups. Group types require
+microversion 3.11 or later.
+
+Example to list Group Types
+
+	client.Microversion = "3.11"
+
+	allPages, err := grouptypes.List(client, grouptypes.ListOpts{}).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+	groupTypes, err := grouptypes.ExtractGroupTypes(allPages)
+	if err != nil {
+		panic(err)
+	}
+	for _, gt := range groupTypes {
+		fmt.Println(gt)
+	}
+
+Example to create a Group Type
+
+	groupType, err := grouptypes.Create(context.TODO(), client, grouptypes.CreateOpts{
+		Name:        "consistent-snapshots",
+		Description: "Groups with crash-consistent snapshots",
+		GroupSpecs: map[string]string{
+			"consistent_group_snapshot_enabled": "<is> True",
+		},
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(groupType)
+
+Example to update a Group Type
+
+	name := "consistent-snapshots-v2"
+	groupType, err := grouptypes.Update(context.TODO(), client, groupTypeID, grouptypes.UpdateOpts{
+		Name: &name,
+	}).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to delete a Group Type
+
+	err := grouptypes.Delete(context.TODO(), client, groupTypeID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to get the default Group Type
+
+	groupType, err := grouptypes.GetDefault(context.TODO(), client).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to manage the Group Specs of a Group Type
+
+	specs, err := grouptypes.ListGroupSpecs(context.TODO(), client, groupTypeID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := grouptypes.GroupSpecsOpts{
+		"consistent_group_replication_enabled": "<is> True",
+	}
+	specs, err = grouptypes.CreateGroupSpecs(context.TODO(), client, groupTypeID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	updateOpts := grouptypes.GroupSpecsOpts{
+		"consistent_group_replication_enabled": "<is> False",
+	}
+	spec, err := grouptypes.UpdateGroupSpec(context.TODO(), client, groupTypeID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = grouptypes.DeleteGroupSpec(context.TODO(), client, groupTypeID, "consistent_group_replication_enabled").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package grouptypes
@@ -0,0 +1,245 @@
+package grouptypes
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToGroupTypeCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains options for creating a Group Type. This object is passed to
+// the grouptypes.Create function. For more information about these parameters,
+// see the Group Type object.
+type CreateOpts struct {
+	// The name of the group type
+	Name string `json:"name" required:"true"`
+	// The group type description
+	Description string `json:"description,omitempty"`
+	// Whether the group type is publicly visible
+	IsPublic *bool `json:"is_public,omitempty"`
+	// Group spec key-value pairs defined by the user.
+	GroupSpecs map[string]string `json:"group_specs,omitempty"`
+}
+
+// ToGroupTypeCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToGroupTypeCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "group_type")
+}
+
+// Create will create a new Group Type based on the values in CreateOpts. To extract
+// the Group Type object from the response, call the Extract method on the
+// CreateResult.
+func Create(ctx context.Context, client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToGroupTypeCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will delete the existing Group Type with the provided ID.
+func Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := client.Delete(ctx, deleteURL(client, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves the Group Type with the provided ID. To extract the Group Type object
+// from the response, call the Extract method on the GetResult.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(ctx, getURL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetDefault retrieves the default Group Type. To extract the Group Type object
+// from the response, call the Extract method on the GetResult.
+func GetDefault(ctx context.Context, client *gophercloud.ServiceClient) (r GetResult) {
+	resp, err := client.Get(ctx, getDefaultURL(client), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToGroupTypeListQuery() (string, error)
+}
+
+// ListOpts holds options for listing Group Types. It is passed to the grouptypes.List
+// function.
+type ListOpts struct {
+	// Comma-separated list of sort keys and optional sort directions in the
+	// form of <key>[:<direction>].
+	Sort string `q:"sort"`
+	// Requests a page size of items.
+	Limit int `q:"limit"`
+	// Used in conjunction with limit to return a slice of items.
+	Offset int `q:"offset"`
+	// The ID of the last-seen item.
+	Marker string `q:"marker"`
+}
+
+// ToGroupTypeListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToGroupTypeListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns Group types.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+
+	if opts != nil {
+		query, err := opts.ToGroupTypeListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return GroupTypePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToGroupTypeUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contain options for updating an existing Group Type. This object is passed
+// to the grouptypes.Update function. For more information about the parameters, see
+// the Group Type object.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	IsPublic    *bool   `json:"is_public,omitempty"`
+}
+
+// ToGroupTypeUpdateMap assembles a request body based on the contents of an
+// UpdateOpts.
+func (opts UpdateOpts) ToGroupTypeUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "group_type")
+}
+
+// Update will update the Group Type with provided information. To extract the updated
+// Group Type from the response, call the Extract method on the UpdateResult.
+func Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToGroupTypeUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListGroupSpecs requests all the group specs for the given group type ID.
+func ListGroupSpecs(ctx context.Context, client *gophercloud.ServiceClient, groupTypeID string) (r ListGroupSpecsResult) {
+	resp, err := client.Get(ctx, groupSpecsListURL(client, groupTypeID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetGroupSpec requests a group spec specified by key for the given group type ID
+func GetGroupSpec(ctx context.Context, client *gophercloud.ServiceClient, groupTypeID string, key string) (r GetGroupSpecResult) {
+	resp, err := client.Get(ctx, groupSpecsGetURL(client, groupTypeID, key), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateGroupSpecsOptsBuilder allows extensions to add additional parameters to the
+// CreateGroupSpecs requests.
+type CreateGroupSpecsOptsBuilder interface {
+	ToGroupTypeGroupSpecsCreateMap() (map[string]any, error)
+}
+
+// GroupSpecsOpts is a map that contains key-value pairs.
+type GroupSpecsOpts map[string]string
+
+// ToGroupTypeGroupSpecsCreateMap assembles a body for a Create request based on
+// the contents of GroupSpecsOpts.
+func (opts GroupSpecsOpts) ToGroupTypeGroupSpecsCreateMap() (map[string]any, error) {
+	return map[string]any{"group_specs": opts}, nil
+}
+
+// CreateGroupSpecs will create or update the group specs key-value pairs for
+// the specified group type.
+func CreateGroupSpecs(ctx context.Context, client *gophercloud.ServiceClient, groupTypeID string, opts CreateGroupSpecsOptsBuilder) (r CreateGroupSpecsResult) {
+	b, err := opts.ToGroupTypeGroupSpecsCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, groupSpecsCreateURL(client, groupTypeID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateGroupSpecOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateGroupSpecOptsBuilder interface {
+	ToGroupTypeGroupSpecUpdateMap() (map[string]string, string, error)
+}
+
+// ToGroupTypeGroupSpecUpdateMap assembles a body for an Update request based on
+// the contents of a GroupSpecsOpts.
+func (opts GroupSpecsOpts) ToGroupTypeGroupSpecUpdateMap() (map[string]string, string, error) {
+	if len(opts) != 1 {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "grouptypes.GroupSpecsOpts"
+		err.Info = "Must have one and only one key-value pair"
+		return nil, "", err
+	}
+
+	var key string
+	for k := range opts {
+		key = k
+	}
+
+	return opts, key, nil
+}
+
+// UpdateGroupSpec will updates the value of the specified group type's group spec
+// for the key in opts.
+func UpdateGroupSpec(ctx context.Context, client *gophercloud.ServiceClient, groupTypeID string, opts UpdateGroupSpecOptsBuilder) (r UpdateGroupSpecResult) {
+	b, key, err := opts.ToGroupTypeGroupSpecUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, groupSpecUpdateURL(client, groupTypeID, key), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteGroupSpec will delete the key-value pair with the given key for the given
+// group type ID.
+func DeleteGroupSpec(ctx context.Context, client *gophercloud.ServiceClient, groupTypeID, key string) (r DeleteGroupSpecResult) {
+	resp, err := client.Delete(ctx, groupSpecDeleteURL(client, groupTypeID, key), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,2 @@
+// Package testing includes grouptypes unit tests
+package testing
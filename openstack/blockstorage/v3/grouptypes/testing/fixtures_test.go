@@ -0,0 +1,188 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/grouptypes"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const GroupTypeID = "7270c56e-6354-4528-8e8b-f54dee2232c8"
+
+const ListResponse = `
+{
+    "group_types": [
+        {
+            "id": "6685584b-1eac-4da6-b5c3-555430cf68ff",
+            "name": "grp-type-001",
+            "description": "group type 001",
+            "is_public": true,
+            "group_specs": {
+                "consistent_group_snapshot_enabled": "<is> False"
+            }
+        },
+        {
+            "id": "7270c56e-6354-4528-8e8b-f54dee2232c8",
+            "name": "consistent-snapshots",
+            "description": "",
+            "is_public": false,
+            "group_specs": {
+                "consistent_group_snapshot_enabled": "<is> True"
+            }
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "group_type": {
+        "id": "7270c56e-6354-4528-8e8b-f54dee2232c8",
+        "name": "consistent-snapshots",
+        "description": "",
+        "is_public": false,
+        "group_specs": {
+            "consistent_group_snapshot_enabled": "<is> True"
+        }
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "group_type": {
+        "name": "consistent-snapshots",
+        "is_public": false,
+        "group_specs": {
+            "consistent_group_snapshot_enabled": "<is> True"
+        }
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "group_type": {
+        "name": "consistent-snapshots-v2",
+        "description": "Crash-consistent snapshots"
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "group_type": {
+        "id": "7270c56e-6354-4528-8e8b-f54dee2232c8",
+        "name": "consistent-snapshots-v2",
+        "description": "Crash-consistent snapshots",
+        "is_public": false,
+        "group_specs": {
+            "consistent_group_snapshot_enabled": "<is> True"
+        }
+    }
+}
+`
+
+// GroupSpecsGetBody provides a GET result of the group_specs for a group type
+const GroupSpecsGetBody = `
+{
+    "group_specs" : {
+        "consistent_group_snapshot_enabled": "<is> True",
+        "consistent_group_replication_enabled": "<is> False"
+    }
+}
+`
+
+// GetGroupSpecBody provides a GET result of a particular group_spec for a group type
+const GetGroupSpecBody = `
+{
+    "consistent_group_snapshot_enabled": "<is> True"
+}
+`
+
+// UpdatedGroupSpecBody provides an PUT result of a particular updated group_spec for a group type
+const UpdatedGroupSpecBody = `
+{
+    "consistent_group_snapshot_enabled": "<is> False"
+}
+`
+
+// ConsistentGroupType is the group type returned by GetResponse.
+var ConsistentGroupType = grouptypes.GroupType{
+	ID:          GroupTypeID,
+	Name:        "consistent-snapshots",
+	Description: "",
+	IsPublic:    false,
+	GroupSpecs: map[string]string{
+		"consistent_group_snapshot_enabled": "<is> True",
+	},
+}
+
+// GroupSpecs is the expected group_specs returned from GET on a group type's group_specs
+var GroupSpecs = map[string]string{
+	"consistent_group_snapshot_enabled":    "<is> True",
+	"consistent_group_replication_enabled": "<is> False",
+}
+
+func handleGroupType(t *testing.T, method, path, request string, status int, response string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, method)
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		if request != "" {
+			th.TestJSONRequest(t, r, request)
+		}
+
+		if response != "" {
+			w.Header().Add("Content-Type", "application/json")
+		}
+		w.WriteHeader(status)
+		fmt.Fprint(w, response)
+	})
+}
+
+func HandleListSuccessfully(t *testing.T) {
+	handleGroupType(t, "GET", "/group_types", "", http.StatusOK, ListResponse)
+}
+
+func HandleGetSuccessfully(t *testing.T) {
+	handleGroupType(t, "GET", "/group_types/"+GroupTypeID, "", http.StatusOK, GetResponse)
+}
+
+func HandleGetDefaultSuccessfully(t *testing.T) {
+	handleGroupType(t, "GET", "/group_types/default", "", http.StatusOK, GetResponse)
+}
+
+func HandleCreateSuccessfully(t *testing.T) {
+	handleGroupType(t, "POST", "/group_types", CreateRequest, http.StatusAccepted, GetResponse)
+}
+
+func HandleUpdateSuccessfully(t *testing.T) {
+	handleGroupType(t, "PUT", "/group_types/"+GroupTypeID, UpdateRequest, http.StatusOK, UpdateResponse)
+}
+
+func HandleDeleteSuccessfully(t *testing.T) {
+	handleGroupType(t, "DELETE", "/group_types/"+GroupTypeID, "", http.StatusAccepted, "")
+}
+
+func HandleGroupSpecsListSuccessfully(t *testing.T) {
+	handleGroupType(t, "GET", "/group_types/"+GroupTypeID+"/group_specs", "", http.StatusOK, GroupSpecsGetBody)
+}
+
+func HandleGroupSpecGetSuccessfully(t *testing.T) {
+	handleGroupType(t, "GET", "/group_types/"+GroupTypeID+"/group_specs/consistent_group_snapshot_enabled", "", http.StatusOK, GetGroupSpecBody)
+}
+
+func HandleGroupSpecsCreateSuccessfully(t *testing.T) {
+	handleGroupType(t, "POST", "/group_types/"+GroupTypeID+"/group_specs", GroupSpecsGetBody, http.StatusAccepted, GroupSpecsGetBody)
+}
+
+func HandleGroupSpecUpdateSuccessfully(t *testing.T) {
+	handleGroupType(t, "PUT", "/group_types/"+GroupTypeID+"/group_specs/consistent_group_snapshot_enabled", UpdatedGroupSpecBody, http.StatusAccepted, UpdatedGroupSpecBody)
+}
+
+func HandleGroupSpecDeleteSuccessfully(t *testing.T) {
+	handleGroupType(t, "DELETE", "/group_types/"+GroupTypeID+"/group_specs/consistent_group_snapshot_enabled", "", http.StatusAccepted, "")
+}
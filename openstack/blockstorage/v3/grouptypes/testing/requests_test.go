@@ -0,0 +1,149 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/grouptypes"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	allPages, err := grouptypes.List(client.ServiceClient(), nil).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := grouptypes.ExtractGroupTypes(allPages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(actual))
+	th.AssertEquals(t, "grp-type-001", actual[0].Name)
+	th.AssertEquals(t, true, actual[0].IsPublic)
+	th.CheckDeepEquals(t, ConsistentGroupType, actual[1])
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := grouptypes.Get(context.TODO(), client.ServiceClient(), GroupTypeID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ConsistentGroupType, *actual)
+}
+
+func TestGetDefault(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetDefaultSuccessfully(t)
+
+	actual, err := grouptypes.GetDefault(context.TODO(), client.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ConsistentGroupType, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	isPublic := false
+	createOpts := grouptypes.CreateOpts{
+		Name:     "consistent-snapshots",
+		IsPublic: &isPublic,
+		GroupSpecs: map[string]string{
+			"consistent_group_snapshot_enabled": "<is> True",
+		},
+	}
+	actual, err := grouptypes.Create(context.TODO(), client.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ConsistentGroupType, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	name := "consistent-snapshots-v2"
+	description := "Crash-consistent snapshots"
+	updateOpts := grouptypes.UpdateOpts{
+		Name:        &name,
+		Description: &description,
+	}
+	actual, err := grouptypes.Update(context.TODO(), client.ServiceClient(), GroupTypeID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, name, actual.Name)
+	th.AssertEquals(t, description, actual.Description)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := grouptypes.Delete(context.TODO(), client.ServiceClient(), GroupTypeID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListGroupSpecs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGroupSpecsListSuccessfully(t)
+
+	actual, err := grouptypes.ListGroupSpecs(context.TODO(), client.ServiceClient(), GroupTypeID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, GroupSpecs, actual)
+}
+
+func TestGetGroupSpec(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGroupSpecGetSuccessfully(t)
+
+	actual, err := grouptypes.GetGroupSpec(context.TODO(), client.ServiceClient(), GroupTypeID, "consistent_group_snapshot_enabled").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, map[string]string{"consistent_group_snapshot_enabled": "<is> True"}, actual)
+}
+
+func TestCreateGroupSpecs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGroupSpecsCreateSuccessfully(t)
+
+	createOpts := grouptypes.GroupSpecsOpts{
+		"consistent_group_snapshot_enabled":    "<is> True",
+		"consistent_group_replication_enabled": "<is> False",
+	}
+	actual, err := grouptypes.CreateGroupSpecs(context.TODO(), client.ServiceClient(), GroupTypeID, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, GroupSpecs, actual)
+}
+
+func TestUpdateGroupSpec(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGroupSpecUpdateSuccessfully(t)
+
+	updateOpts := grouptypes.GroupSpecsOpts{
+		"consistent_group_snapshot_enabled": "<is> False",
+	}
+	actual, err := grouptypes.UpdateGroupSpec(context.TODO(), client.ServiceClient(), GroupTypeID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, map[string]string{"consistent_group_snapshot_enabled": "<is> False"}, actual)
+
+	_, err = grouptypes.UpdateGroupSpec(context.TODO(), client.ServiceClient(), GroupTypeID, grouptypes.GroupSpecsOpts{}).Extract()
+	th.AssertErr(t, err)
+}
+
+func TestDeleteGroupSpec(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGroupSpecDeleteSuccessfully(t)
+
+	err := grouptypes.DeleteGroupSpec(context.TODO(), client.ServiceClient(), GroupTypeID, "consistent_group_snapshot_enabled").ExtractErr()
+	th.AssertNoErr(t, err)
+}
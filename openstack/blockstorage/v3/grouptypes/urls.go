@@ -0,0 +1,47 @@
+package grouptypes
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("group_types")
+}
+
+func getURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("group_types", id)
+}
+
+func getDefaultURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("group_types", "default")
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("group_types")
+}
+
+func deleteURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("group_types", id)
+}
+
+func updateURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("group_types", id)
+}
+
+func groupSpecsListURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("group_types", id, "group_specs")
+}
+
+func groupSpecsGetURL(client *gophercloud.ServiceClient, id, key string) string {
+	return client.ServiceURL("group_types", id, "group_specs", key)
+}
+
+func groupSpecsCreateURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("group_types", id, "group_specs")
+}
+
+func groupSpecUpdateURL(client *gophercloud.ServiceClient, id, key string) string {
+	return client.ServiceURL("group_types", id, "group_specs", key)
+}
+
+func groupSpecDeleteURL(client *gophercloud.ServiceClient, id, key string) string {
+	return client.ServiceURL("group_types", id, "group_specs", key)
+}
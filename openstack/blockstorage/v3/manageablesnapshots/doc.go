@@ -0,0 +1,50 @@
+/*
+Package manageablesnapshots lists the snapshots of a storage backend which are
+not managed by the OpenStack Block Storage service, and adopts them as Block
+Storage snapshots of managed volumes. Manageable snapshots require microversion
+3.8 or later.
+
+Adopted snapshots are released with snapshots.Unmanage, which leaves them on
+the backend.
+
+Example to list the Manageable Snapshots of a Backend
+
+	client.Microversion = "3.8"
+
+	listOpts := manageablesnapshots.ListOpts{
+		Host: "cinder-volume-01@netapp#pool1",
+	}
+
+	allPages, err := manageablesnapshots.ListDetail(client, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allSnapshots, err := manageablesnapshots.ExtractManageableSnapshots(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, s := range allSnapshots {
+		fmt.Printf("%v of %v: %t\n", s.Reference, s.SourceReference, s.SafeToManage)
+	}
+
+Example to adopt an existing Snapshot
+
+	manageOpts := manageablesnapshots.ManageExistingOpts{
+		VolumeID: "96c3bda7-c82a-4f50-be73-ca7621794835",
+		Ref:      map[string]any{"source-name": "snap-001"},
+		Name:     "database-before-upgrade",
+	}
+
+	snapshot, err := manageablesnapshots.ManageExisting(context.TODO(), client, manageOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = snapshots.WaitForStatus(context.TODO(), client, snapshot.ID, "available")
+	if err != nil {
+		panic(err)
+	}
+*/
+package manageablesnapshots
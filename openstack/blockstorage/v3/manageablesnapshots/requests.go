@@ -0,0 +1,129 @@
+package manageablesnapshots
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToManageableSnapshotListQuery() (string, error)
+}
+
+// ListOpts holds options for listing the snapshots of a storage backend that
+// are not managed by the Block Storage service. Exactly one of Host and
+// Cluster must be set.
+type ListOpts struct {
+	// Host is the host of the backend to list, in the form
+	// "host@backend#pool".
+	Host string `q:"host"`
+
+	// Cluster is the cluster of the backend to list. It requires
+	// microversion 3.17 or later.
+	Cluster string `q:"cluster"`
+
+	// Comma-separated list of sort keys and optional sort directions in the
+	// form of <key>[:<direction>]. Valid keys are "size" and "reference".
+	Sort string `q:"sort"`
+
+	// Requests a page size of items.
+	Limit int `q:"limit"`
+
+	// Used in conjunction with limit to return a slice of items.
+	Offset int `q:"offset"`
+}
+
+// ToManageableSnapshotListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToManageableSnapshotListQuery() (string, error) {
+	if (opts.Host == "") == (opts.Cluster == "") {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "Host/Cluster"
+		err.Info = "Exactly one of Host and Cluster must be provided"
+		return "", err
+	}
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a summary of the snapshots of a storage backend that may be
+// adopted with ManageExisting. It requires microversion 3.8 or later.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listURL(client), opts)
+}
+
+// ListDetail returns the snapshots of a storage backend that may be adopted
+// with ManageExisting, along with the reason some of them are not safe to
+// manage. It requires microversion 3.8 or later.
+func ListDetail(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listDetailURL(client), opts)
+}
+
+func list(client *gophercloud.ServiceClient, url string, opts ListOptsBuilder) pagination.Pager {
+	if opts != nil {
+		query, err := opts.ToManageableSnapshotListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		p := ManageableSnapshotPage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+}
+
+// ManageExistingOptsBuilder allows extensions to add additional parameters to
+// the ManageExisting request.
+type ManageExistingOptsBuilder interface {
+	ToManageableSnapshotManageExistingMap() (map[string]any, error)
+}
+
+// ManageExistingOpts contains options for adopting an existing snapshot of a
+// storage backend. This object is passed to the
+// manageablesnapshots.ManageExisting function.
+type ManageExistingOpts struct {
+	// VolumeID is the ID of the managed volume the snapshot was taken from.
+	VolumeID string `json:"volume_id" required:"true"`
+
+	// Ref identifies the snapshot on the backend, as returned in the
+	// Reference of a ManageableSnapshot, e.g. {"source-name": "snap-001"}.
+	Ref map[string]any `json:"ref" required:"true"`
+
+	// Name is the name of the adopted snapshot.
+	Name string `json:"name,omitempty"`
+
+	// Description is the description of the adopted snapshot.
+	Description string `json:"description,omitempty"`
+
+	// Metadata is the metadata of the adopted snapshot.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// ToManageableSnapshotManageExistingMap assembles a request body based on the
+// contents of a ManageExistingOpts.
+func (opts ManageExistingOpts) ToManageableSnapshotManageExistingMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "snapshot")
+}
+
+// ManageExisting adopts an existing snapshot of a storage backend into the
+// Block Storage service. The snapshot is "creating" until the backend has
+// taken it over; wait for it with snapshots.WaitForStatus. To extract the
+// snapshots.Snapshot from the response, call the Extract method on the
+// ManageExistingResult.
+func ManageExisting(ctx context.Context, client *gophercloud.ServiceClient, opts ManageExistingOptsBuilder) (r ManageExistingResult) {
+	b, err := opts.ToManageableSnapshotManageExistingMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, manageURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
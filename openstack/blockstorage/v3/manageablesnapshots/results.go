@@ -0,0 +1,146 @@
+package manageablesnapshots
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/snapshots"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+const (
+	invalidMarker = "-1"
+)
+
+// ManageableSnapshot is a snapshot of a storage backend which may be adopted
+// by the Block Storage service.
+type ManageableSnapshot struct {
+	// Reference identifies the snapshot on the backend. Pass it as the Ref of
+	// ManageExistingOpts to adopt the snapshot.
+	Reference map[string]any `json:"reference"`
+
+	// SourceReference identifies the volume the snapshot was taken from on
+	// the backend.
+	SourceReference map[string]any `json:"source_reference"`
+
+	// Size is the size of the snapshot, in GiB.
+	Size int `json:"size"`
+
+	// SafeToManage tells whether the snapshot may be adopted.
+	SafeToManage bool `json:"safe_to_manage"`
+
+	// ReasonNotSafe tells why the snapshot may not be adopted. It is only
+	// returned by ListDetail.
+	ReasonNotSafe string `json:"reason_not_safe"`
+
+	// CinderID is the ID of the snapshot if it is already managed by the
+	// Block Storage service. It is only returned by ListDetail.
+	CinderID string `json:"cinder_id"`
+
+	// ExtraInfo holds backend specific information about the snapshot. It is
+	// only returned by ListDetail.
+	ExtraInfo map[string]any `json:"extra_info"`
+}
+
+// ManageableSnapshotPage is a pagination.Pager that is returned from a call to
+// the List and ListDetail functions.
+type ManageableSnapshotPage struct {
+	pagination.MarkerPageBase
+}
+
+// NextPageURL generates the URL for the page of results after this one.
+func (r ManageableSnapshotPage) NextPageURL() (string, error) {
+	currentURL := r.URL
+	mark, err := r.Owner.LastMarker()
+	if err != nil {
+		return "", err
+	}
+	if mark == invalidMarker {
+		return "", nil
+	}
+
+	q := currentURL.Query()
+	q.Set("offset", mark)
+	currentURL.RawQuery = q.Encode()
+	return currentURL.String(), nil
+}
+
+// LastMarker returns the last offset in a ListResult.
+func (r ManageableSnapshotPage) LastMarker() (string, error) {
+	manageableSnapshots, err := ExtractManageableSnapshots(r)
+	if err != nil {
+		return invalidMarker, err
+	}
+	if len(manageableSnapshots) == 0 {
+		return invalidMarker, nil
+	}
+
+	u, err := url.Parse(r.URL.String())
+	if err != nil {
+		return invalidMarker, err
+	}
+	queryParams := u.Query()
+	offset := queryParams.Get("offset")
+	limit := queryParams.Get("limit")
+
+	// Limit is not present, only one page required
+	if limit == "" {
+		return invalidMarker, nil
+	}
+
+	iOffset := 0
+	if offset != "" {
+		iOffset, err = strconv.Atoi(offset)
+		if err != nil {
+			return invalidMarker, err
+		}
+	}
+	iLimit, err := strconv.Atoi(limit)
+	if err != nil {
+		return invalidMarker, err
+	}
+	iOffset = iOffset + iLimit
+	offset = strconv.Itoa(iOffset)
+
+	return offset, nil
+}
+
+// IsEmpty satisifies the IsEmpty method of the Page interface
+func (r ManageableSnapshotPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	manageableSnapshots, err := ExtractManageableSnapshots(r)
+	return len(manageableSnapshots) == 0, err
+}
+
+// ExtractManageableSnapshots extracts and returns ManageableSnapshots. It is
+// used while iterating over a manageablesnapshots.List or ListDetail call.
+func ExtractManageableSnapshots(r pagination.Page) ([]ManageableSnapshot, error) {
+	var s struct {
+		ManageableSnapshots []ManageableSnapshot `json:"manageable-snapshots"`
+	}
+	err := (r.(ManageableSnapshotPage)).ExtractInto(&s)
+	return s.ManageableSnapshots, err
+}
+
+// ManageExistingResult contains the response body and error from a
+// ManageExisting request.
+type ManageExistingResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the adopted snapshots.Snapshot out of the
+// ManageExistingResult.
+func (r ManageExistingResult) Extract() (*snapshots.Snapshot, error) {
+	var s snapshots.Snapshot
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtractInto converts our response data into a snapshot struct.
+func (r ManageExistingResult) ExtractInto(v any) error {
+	return r.Result.ExtractIntoStructPtr(v, "snapshot")
+}
@@ -0,0 +1,2 @@
+// Package testing includes manageablesnapshots unit tests
+package testing
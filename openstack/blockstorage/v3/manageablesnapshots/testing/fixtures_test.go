@@ -0,0 +1,103 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/manageablesnapshots"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const Host = "cinder-volume-01@netapp#pool1"
+
+const ListDetailResponse = `
+{
+    "manageable-snapshots": [
+        {
+            "reference": {"source-name": "snap-001"},
+            "source_reference": {"source-name": "volume-96c3bda7-c82a-4f50-be73-ca7621794835"},
+            "size": 20,
+            "safe_to_manage": true,
+            "reason_not_safe": null,
+            "cinder_id": null,
+            "extra_info": null
+        },
+        {
+            "reference": {"source-name": "snapshot-d32019d3-bc6e-4319-9c1d-6722fc136a22"},
+            "source_reference": {"source-name": "volume-96c3bda7-c82a-4f50-be73-ca7621794835"},
+            "size": 20,
+            "safe_to_manage": false,
+            "reason_not_safe": "already managed",
+            "cinder_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "extra_info": null
+        }
+    ]
+}
+`
+
+const ManageExistingRequest = `
+{
+    "snapshot": {
+        "volume_id": "96c3bda7-c82a-4f50-be73-ca7621794835",
+        "ref": {"source-name": "snap-001"},
+        "name": "database-before-upgrade",
+        "metadata": {"origin": "array-01"}
+    }
+}
+`
+
+const ManageExistingResponse = `
+{
+    "snapshot": {
+        "id": "1b0c9f2e-58f7-4b7e-92a6-3b0a3d2e7c11",
+        "volume_id": "96c3bda7-c82a-4f50-be73-ca7621794835",
+        "name": "database-before-upgrade",
+        "status": "creating",
+        "size": 20,
+        "metadata": {"origin": "array-01"}
+    }
+}
+`
+
+// ManageableSnapshots are the manageable snapshots of ListDetailResponse.
+var ManageableSnapshots = []manageablesnapshots.ManageableSnapshot{
+	{
+		Reference:       map[string]any{"source-name": "snap-001"},
+		SourceReference: map[string]any{"source-name": "volume-96c3bda7-c82a-4f50-be73-ca7621794835"},
+		Size:            20,
+		SafeToManage:    true,
+	},
+	{
+		Reference:       map[string]any{"source-name": "snapshot-d32019d3-bc6e-4319-9c1d-6722fc136a22"},
+		SourceReference: map[string]any{"source-name": "volume-96c3bda7-c82a-4f50-be73-ca7621794835"},
+		Size:            20,
+		ReasonNotSafe:   "already managed",
+		CinderID:        "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+	},
+}
+
+func HandleListDetailSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/manageable_snapshots/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"host": Host, "sort": "size:desc"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListDetailResponse)
+	})
+}
+
+func HandleManageExistingSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/manageable_snapshots", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, ManageExistingRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, ManageExistingResponse)
+	})
+}
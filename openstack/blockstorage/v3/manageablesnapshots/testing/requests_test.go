@@ -0,0 +1,53 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/manageablesnapshots"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestListDetail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListDetailSuccessfully(t)
+
+	listOpts := manageablesnapshots.ListOpts{Host: Host, Sort: "size:desc"}
+	allPages, err := manageablesnapshots.ListDetail(client.ServiceClient(), listOpts).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := manageablesnapshots.ExtractManageableSnapshots(allPages)
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, ManageableSnapshots, actual)
+}
+
+func TestManageExisting(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleManageExistingSuccessfully(t)
+
+	manageOpts := manageablesnapshots.ManageExistingOpts{
+		VolumeID: "96c3bda7-c82a-4f50-be73-ca7621794835",
+		Ref:      map[string]any{"source-name": "snap-001"},
+		Name:     "database-before-upgrade",
+		Metadata: map[string]string{"origin": "array-01"},
+	}
+	actual, err := manageablesnapshots.ManageExisting(context.TODO(), client.ServiceClient(), manageOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "1b0c9f2e-58f7-4b7e-92a6-3b0a3d2e7c11", actual.ID)
+	th.AssertEquals(t, "creating", actual.Status)
+	th.AssertEquals(t, "96c3bda7-c82a-4f50-be73-ca7621794835", actual.VolumeID)
+}
+
+func TestManageExistingRequiresVolumeID(t *testing.T) {
+	manageOpts := manageablesnapshots.ManageExistingOpts{
+		Ref: map[string]any{"source-name": "snap-001"},
+	}
+	_, err := manageOpts.ToManageableSnapshotManageExistingMap()
+	if err == nil {
+		t.Fatal("expected an error for a missing volume ID")
+	}
+}
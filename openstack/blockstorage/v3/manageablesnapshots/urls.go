@@ -0,0 +1,15 @@
+package manageablesnapshots
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+func manageURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("manageable_snapshots")
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("manageable_snapshots")
+}
+
+func listDetailURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("manageable_snapshots", "detail")
+}
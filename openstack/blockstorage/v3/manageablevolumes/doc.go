@@ -0,0 +1,54 @@
+/*
+Package manageablevolumes lists the volumes of a storage backend which are not
+managed by the OpenStack Block Storage service, and adopts them as Block
+Storage volumes. This is how LUNs of an existing storage array are brought
+into OpenStack. Manageable volumes require microversion 3.8 or later.
+
+Adopted volumes are released with volumes.Unmanage, which leaves them on the
+backend.
+
+Example to list the Manageable Volumes of a Backend
+
+	client.Microversion = "3.8"
+
+	listOpts := manageablevolumes.ListOpts{
+		Host:  "cinder-volume-01@netapp#pool1",
+		Limit: 100,
+	}
+
+	allPages, err := manageablevolumes.ListDetail(client, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allVolumes, err := manageablevolumes.ExtractManageableVolumes(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, v := range allVolumes {
+		if !v.SafeToManage {
+			fmt.Printf("%v: %s\n", v.Reference, v.ReasonNotSafe)
+		}
+	}
+
+Example to adopt an existing Volume
+
+	manageOpts := manageablevolumes.ManageExistingOpts{
+		Host:       "cinder-volume-01@netapp#pool1",
+		Ref:        map[string]any{"source-name": "lun-001"},
+		Name:       "database",
+		VolumeType: "netapp-ssd",
+	}
+
+	volume, err := manageablevolumes.ManageExisting(context.TODO(), client, manageOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = volumes.WaitForStatus(context.TODO(), client, volume.ID, "available")
+	if err != nil {
+		panic(err)
+	}
+*/
+package manageablevolumes
@@ -0,0 +1,142 @@
+package manageablevolumes
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+const (
+	invalidMarker = "-1"
+)
+
+// ManageableVolume is a volume of a storage backend which may be adopted by
+// the Block Storage service.
+type ManageableVolume struct {
+	// Reference identifies the volume on the backend. Pass it as the Ref of
+	// ManageExistingOpts to adopt the volume.
+	Reference map[string]any `json:"reference"`
+
+	// Size is the size of the volume, in GiB.
+	Size int `json:"size"`
+
+	// SafeToManage tells whether the volume may be adopted.
+	SafeToManage bool `json:"safe_to_manage"`
+
+	// ReasonNotSafe tells why the volume may not be adopted. It is only
+	// returned by ListDetail.
+	ReasonNotSafe string `json:"reason_not_safe"`
+
+	// CinderID is the ID of the volume if it is already managed by the Block
+	// Storage service. It is only returned by ListDetail.
+	CinderID string `json:"cinder_id"`
+
+	// ExtraInfo holds backend specific information about the volume. It is
+	// only returned by ListDetail.
+	ExtraInfo map[string]any `json:"extra_info"`
+}
+
+// ManageableVolumePage is a pagination.Pager that is returned from a call to
+// the List and ListDetail functions.
+type ManageableVolumePage struct {
+	pagination.MarkerPageBase
+}
+
+// NextPageURL generates the URL for the page of results after this one.
+func (r ManageableVolumePage) NextPageURL() (string, error) {
+	currentURL := r.URL
+	mark, err := r.Owner.LastMarker()
+	if err != nil {
+		return "", err
+	}
+	if mark == invalidMarker {
+		return "", nil
+	}
+
+	q := currentURL.Query()
+	q.Set("offset", mark)
+	currentURL.RawQuery = q.Encode()
+	return currentURL.String(), nil
+}
+
+// LastMarker returns the last offset in a ListResult.
+func (r ManageableVolumePage) LastMarker() (string, error) {
+	manageableVolumes, err := ExtractManageableVolumes(r)
+	if err != nil {
+		return invalidMarker, err
+	}
+	if len(manageableVolumes) == 0 {
+		return invalidMarker, nil
+	}
+
+	u, err := url.Parse(r.URL.String())
+	if err != nil {
+		return invalidMarker, err
+	}
+	queryParams := u.Query()
+	offset := queryParams.Get("offset")
+	limit := queryParams.Get("limit")
+
+	// Limit is not present, only one page required
+	if limit == "" {
+		return invalidMarker, nil
+	}
+
+	iOffset := 0
+	if offset != "" {
+		iOffset, err = strconv.Atoi(offset)
+		if err != nil {
+			return invalidMarker, err
+		}
+	}
+	iLimit, err := strconv.Atoi(limit)
+	if err != nil {
+		return invalidMarker, err
+	}
+	iOffset = iOffset + iLimit
+	offset = strconv.Itoa(iOffset)
+
+	return offset, nil
+}
+
+// IsEmpty satisifies the IsEmpty method of the Page interface
+func (r ManageableVolumePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	manageableVolumes, err := ExtractManageableVolumes(r)
+	return len(manageableVolumes) == 0, err
+}
+
+// ExtractManageableVolumes extracts and returns ManageableVolumes. It is used
+// while iterating over a manageablevolumes.List or ListDetail call.
+func ExtractManageableVolumes(r pagination.Page) ([]ManageableVolume, error) {
+	var s struct {
+		ManageableVolumes []ManageableVolume `json:"manageable-volumes"`
+	}
+	err := (r.(ManageableVolumePage)).ExtractInto(&s)
+	return s.ManageableVolumes, err
+}
+
+// ManageExistingResult contains the response body and error from a
+// ManageExisting request.
+type ManageExistingResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the adopted volumes.Volume out of the
+// ManageExistingResult.
+func (r ManageExistingResult) Extract() (*volumes.Volume, error) {
+	var s volumes.Volume
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtractInto converts our response data into a volume struct.
+func (r ManageExistingResult) ExtractInto(v any) error {
+	return r.Result.ExtractIntoStructPtr(v, "volume")
+}
@@ -0,0 +1,2 @@
+// Package testing includes manageablevolumes unit tests
+package testing
@@ -0,0 +1,169 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/manageablevolumes"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const Host = "cinder-volume-01@netapp#pool1"
+
+const ListResponse = `
+{
+    "manageable-volumes": [
+        {
+            "reference": {"source-name": "lun-001"},
+            "size": 10,
+            "safe_to_manage": true
+        },
+        {
+            "reference": {"source-name": "volume-96c3bda7-c82a-4f50-be73-ca7621794835"},
+            "size": 20,
+            "safe_to_manage": false
+        }
+    ]
+}
+`
+
+const ListDetailPage1Response = `
+{
+    "manageable-volumes": [
+        {
+            "reference": {"source-name": "lun-001"},
+            "size": 10,
+            "safe_to_manage": true,
+            "reason_not_safe": null,
+            "cinder_id": null,
+            "extra_info": {"qos": "gold"}
+        },
+        {
+            "reference": {"source-name": "volume-96c3bda7-c82a-4f50-be73-ca7621794835"},
+            "size": 20,
+            "safe_to_manage": false,
+            "reason_not_safe": "already managed",
+            "cinder_id": "96c3bda7-c82a-4f50-be73-ca7621794835",
+            "extra_info": null
+        }
+    ]
+}
+`
+
+const ListDetailPage2Response = `
+{
+    "manageable-volumes": [
+        {
+            "reference": {"source-name": "lun-003"},
+            "size": 1,
+            "safe_to_manage": false,
+            "reason_not_safe": "volume in use",
+            "cinder_id": null,
+            "extra_info": null
+        }
+    ]
+}
+`
+
+const ManageExistingRequest = `
+{
+    "volume": {
+        "host": "cinder-volume-01@netapp#pool1",
+        "ref": {"source-name": "lun-001"},
+        "name": "database",
+        "volume_type": "netapp-ssd",
+        "bootable": true
+    }
+}
+`
+
+const ManageExistingResponse = `
+{
+    "volume": {
+        "id": "289da7f8-6440-407c-9fb4-7db01ec49164",
+        "name": "database",
+        "status": "creating",
+        "size": 10,
+        "volume_type": "netapp-ssd",
+        "bootable": "true",
+        "availability_zone": "nova",
+        "attachments": [],
+        "metadata": {}
+    }
+}
+`
+
+// ManageableVolumes are the manageable volumes of ListDetailPage1Response and
+// ListDetailPage2Response.
+var ManageableVolumes = []manageablevolumes.ManageableVolume{
+	{
+		Reference:    map[string]any{"source-name": "lun-001"},
+		Size:         10,
+		SafeToManage: true,
+		ExtraInfo:    map[string]any{"qos": "gold"},
+	},
+	{
+		Reference:     map[string]any{"source-name": "volume-96c3bda7-c82a-4f50-be73-ca7621794835"},
+		Size:          20,
+		ReasonNotSafe: "already managed",
+		CinderID:      "96c3bda7-c82a-4f50-be73-ca7621794835",
+	},
+	{
+		Reference:     map[string]any{"source-name": "lun-003"},
+		Size:          1,
+		ReasonNotSafe: "volume in use",
+	},
+}
+
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/manageable_volumes", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"host": Host})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+// HandleListDetailSuccessfully serves the manageable volumes two at a time.
+func HandleListDetailSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/manageable_volumes/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		switch offset := r.Form.Get("offset"); offset {
+		case "":
+			th.TestFormValues(t, r, map[string]string{"host": Host, "limit": "2"})
+			fmt.Fprint(w, ListDetailPage1Response)
+		case "2":
+			th.TestFormValues(t, r, map[string]string{"host": Host, "limit": "2", "offset": "2"})
+			fmt.Fprint(w, ListDetailPage2Response)
+		case "4":
+			fmt.Fprint(w, `{"manageable-volumes": []}`)
+		default:
+			t.Fatalf("Unexpected offset: [%s]", offset)
+		}
+	})
+}
+
+func HandleManageExistingSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/manageable_volumes", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, ManageExistingRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, ManageExistingResponse)
+	})
+}
@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/manageablevolumes"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	allPages, err := manageablevolumes.List(client.ServiceClient(), manageablevolumes.ListOpts{Host: Host}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := manageablevolumes.ExtractManageableVolumes(allPages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(actual))
+	th.AssertEquals(t, "lun-001", actual[0].Reference["source-name"])
+	th.AssertEquals(t, true, actual[0].SafeToManage)
+	th.AssertEquals(t, 20, actual[1].Size)
+}
+
+func TestListDetail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListDetailSuccessfully(t)
+
+	listOpts := manageablevolumes.ListOpts{Host: Host, Limit: 2}
+	allPages, err := manageablevolumes.ListDetail(client.ServiceClient(), listOpts).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := manageablevolumes.ExtractManageableVolumes(allPages)
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, ManageableVolumes, actual)
+}
+
+func TestListRequiresHostOrCluster(t *testing.T) {
+	for _, listOpts := range []manageablevolumes.ListOpts{
+		{},
+		{Host: Host, Cluster: "cluster@netapp"},
+	} {
+		_, err := manageablevolumes.List(client.ServiceClient(), listOpts).AllPages(context.TODO())
+		if err == nil {
+			t.Fatalf("expected an error for %+v", listOpts)
+		}
+	}
+}
+
+func TestManageExisting(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleManageExistingSuccessfully(t)
+
+	manageOpts := manageablevolumes.ManageExistingOpts{
+		Host:       Host,
+		Ref:        map[string]any{"source-name": "lun-001"},
+		Name:       "database",
+		VolumeType: "netapp-ssd",
+		Bootable:   true,
+	}
+	actual, err := manageablevolumes.ManageExisting(context.TODO(), client.ServiceClient(), manageOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "289da7f8-6440-407c-9fb4-7db01ec49164", actual.ID)
+	th.AssertEquals(t, "creating", actual.Status)
+	th.AssertEquals(t, 10, actual.Size)
+}
+
+func TestManageExistingRequiresRef(t *testing.T) {
+	manageOpts := manageablevolumes.ManageExistingOpts{Host: Host}
+	_, err := manageOpts.ToManageableVolumeManageExistingMap()
+	if err == nil {
+		t.Fatal("expected an error for a missing ref")
+	}
+}
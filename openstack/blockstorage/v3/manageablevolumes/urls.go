@@ -0,0 +1,15 @@
+package manageablevolumes
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+func manageURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("manageable_volumes")
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("manageable_volumes")
+}
+
+func listDetailURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("manageable_volumes", "detail")
+}
@@ -0,0 +1,57 @@
+/*
+Package messages provides information and interaction with the user messages
+of the OpenStack Block Storage service. Messages explain why asynchronous
+operations, such as the creation of a volume, failed. Messages require
+microversion 3.3 or later, and their filtering and sorting 3.5 or later.
+
+volumes.WaitForStatus and snapshots.WaitForStatus attach the latest message
+about a resource in error to the error they return. AttachLatest does the same
+for any other error about a resource.
+
+Example to List the Messages of a Volume
+
+	client.Microversion = "3.5"
+
+	listOpts := messages.ListOpts{
+		ResourceType: messages.ResourceTypeVolume,
+		ResourceUUID: volumeID,
+	}
+
+	allPages, err := messages.List(client, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allMessages, err := messages.ExtractMessages(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, message := range allMessages {
+		fmt.Printf("%s: %s\n", message.CreatedAt, message.UserMessage)
+	}
+
+Example to Get a Message
+
+	message, err := messages.Get(context.TODO(), client, messageID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Message
+
+	err := messages.Delete(context.TODO(), client, messageID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Explain why a Volume failed
+
+	err := volumes.WaitForStatus(context.TODO(), client, volumeID, "available")
+
+	var errWithMessage messages.ErrWithMessage
+	if errors.As(err, &errWithMessage) {
+		fmt.Printf("%s (%s)\n", errWithMessage.Message.UserMessage, errWithMessage.Message.EventID)
+	}
+*/
+package messages
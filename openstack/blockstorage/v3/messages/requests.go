@@ -0,0 +1,81 @@
+package messages
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// Delete will delete the existing Message with the provided ID.
+func Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := client.Delete(ctx, deleteURL(client, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToMessageListQuery() (string, error)
+}
+
+// ListOpts holds options for listing Messages. It is passed to the
+// messages.List function. Filtering and sorting require microversion 3.5 or
+// later.
+type ListOpts struct {
+	// The UUID of the resource for which the message was created
+	ResourceUUID string `q:"resource_uuid"`
+	// The type of the resource for which the message was created
+	ResourceType string `q:"resource_type"`
+	// The ID of the event which generated the message
+	EventID string `q:"event_id"`
+	// The message level
+	MessageLevel string `q:"message_level"`
+	// The UUID of the request during which the message was created
+	RequestID string `q:"request_id"`
+
+	// Comma-separated list of sort keys and optional sort directions in the
+	// form of <key>[:<direction>].
+	Sort string `q:"sort"`
+
+	// Requests a page size of items.
+	Limit int `q:"limit"`
+
+	// Used in conjunction with limit to return a slice of items.
+	Offset int `q:"offset"`
+
+	// The ID of the last-seen item.
+	Marker string `q:"marker"`
+}
+
+// ToMessageListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToMessageListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns Messages optionally limited by the conditions provided in
+// ListOpts. It requires microversion 3.3 or later.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToMessageListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return MessagePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves the Message with the provided ID. To extract the Message
+// object from the response, call the Extract method on the GetResult.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(ctx, getURL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,121 @@
+package messages
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// Resource types of the messages of the Block Storage service.
+const (
+	ResourceTypeVolume   = "VOLUME"
+	ResourceTypeSnapshot = "VOLUME_SNAPSHOT"
+	ResourceTypeBackup   = "VOLUME_BACKUP"
+)
+
+// Message contains all the information associated with a message the Block
+// Storage service recorded about an asynchronous operation.
+type Message struct {
+	// The message ID
+	ID string `json:"id"`
+	// The ID of the event which generated the message
+	EventID string `json:"event_id"`
+	// The message text
+	UserMessage string `json:"user_message"`
+	// The message level
+	MessageLevel string `json:"message_level"`
+	// The UUID of the request during which the message was created
+	RequestID string `json:"request_id"`
+	// The UUID of the resource for which the message was created
+	ResourceUUID string `json:"resource_uuid"`
+	// The type of the resource for which the message was created
+	ResourceType string `json:"resource_type"`
+	// The date and time stamp when the message was created
+	CreatedAt time.Time `json:"-"`
+	// The date and time stamp until which the message is kept
+	GuaranteedUntil time.Time `json:"-"`
+}
+
+// UnmarshalJSON converts our JSON API response into our message struct
+func (r *Message) UnmarshalJSON(b []byte) error {
+	type tmp Message
+	var s struct {
+		tmp
+		CreatedAt       gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		GuaranteedUntil gophercloud.JSONRFC3339MilliNoZ `json:"guaranteed_until"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Message(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.GuaranteedUntil = time.Time(s.GuaranteedUntil)
+
+	return nil
+}
+
+// MessagePage is a pagination.Pager that is returned from a call to the List
+// function.
+type MessagePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a MessagePage contains no Messages.
+func (r MessagePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	messages, err := ExtractMessages(r)
+	return len(messages) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r MessagePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"messages_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// ExtractMessages extracts and returns Messages. It is used while iterating
+// over a messages.List call.
+func ExtractMessages(r pagination.Page) ([]Message, error) {
+	var s struct {
+		Messages []Message `json:"messages"`
+	}
+	err := (r.(MessagePage)).ExtractInto(&s)
+	return s.Messages, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the Message object out of the commonResult object.
+func (r commonResult) Extract() (*Message, error) {
+	var s struct {
+		Message *Message `json:"message"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Message, err
+}
+
+// DeleteResult contains the response body and error from a Delete request.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
@@ -0,0 +1,2 @@
+// Package testing includes messages unit tests
+package testing
@@ -0,0 +1,130 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/messages"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const VolumeID = "5aa119a8-d25b-45a7-8d1b-88e127885635"
+
+const ListResponse = `
+{
+    "messages": [
+        {
+            "id": "c506cd4b-9048-43bc-97ef-0d7dec369b42",
+            "event_id": "VOLUME_VOLUME_001_002",
+            "user_message": "create volume: Schedule allocate volume: Could not find any available weighted backend.",
+            "message_level": "ERROR",
+            "resource_type": "VOLUME",
+            "resource_uuid": "5aa119a8-d25b-45a7-8d1b-88e127885635",
+            "created_at": "2024-03-14T10:32:27.000000",
+            "guaranteed_until": "2024-04-13T10:32:27.000000",
+            "request_id": "req-c1216709-afba-4703-a1a3-22eda88f2f5a",
+            "links": []
+        }
+    ],
+    "messages_links": [
+        {
+            "href": "%s/messages?marker=c506cd4b-9048-43bc-97ef-0d7dec369b42",
+            "rel": "next"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "message": {
+        "id": "c506cd4b-9048-43bc-97ef-0d7dec369b42",
+        "event_id": "VOLUME_VOLUME_001_002",
+        "user_message": "create volume: Schedule allocate volume: Could not find any available weighted backend.",
+        "message_level": "ERROR",
+        "resource_type": "VOLUME",
+        "resource_uuid": "5aa119a8-d25b-45a7-8d1b-88e127885635",
+        "created_at": "2024-03-14T10:32:27.000000",
+        "guaranteed_until": "2024-04-13T10:32:27.000000",
+        "request_id": "req-c1216709-afba-4703-a1a3-22eda88f2f5a",
+        "links": []
+    }
+}
+`
+
+// Message is the message of ListResponse and GetResponse.
+var Message = messages.Message{
+	ID:              "c506cd4b-9048-43bc-97ef-0d7dec369b42",
+	EventID:         "VOLUME_VOLUME_001_002",
+	UserMessage:     "create volume: Schedule allocate volume: Could not find any available weighted backend.",
+	MessageLevel:    "ERROR",
+	ResourceType:    "VOLUME",
+	ResourceUUID:    VolumeID,
+	CreatedAt:       time.Date(2024, 3, 14, 10, 32, 27, 0, time.UTC),
+	GuaranteedUntil: time.Date(2024, 4, 13, 10, 32, 27, 0, time.UTC),
+	RequestID:       "req-c1216709-afba-4703-a1a3-22eda88f2f5a",
+}
+
+// HandleListSuccessfully serves ListResponse, filtered on VolumeID, and then
+// an empty page.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/messages", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		switch marker := r.Form.Get("marker"); marker {
+		case "":
+			th.TestFormValues(t, r, map[string]string{"resource_type": "VOLUME", "resource_uuid": VolumeID})
+			fmt.Fprintf(w, ListResponse, th.Server.URL)
+		case "c506cd4b-9048-43bc-97ef-0d7dec369b42":
+			fmt.Fprint(w, `{"messages": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+// HandleLatestSuccessfully expects the latest message about VolumeID to be
+// requested with microversion 3.5, and serves ListResponse.
+func HandleLatestSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/messages", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "OpenStack-API-Version", "volume 3.5")
+		th.TestFormValues(t, r, map[string]string{
+			"resource_type": "VOLUME",
+			"resource_uuid": VolumeID,
+			"sort":          "created_at:desc",
+			"limit":         "1",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ListResponse, th.Server.URL)
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/messages/c506cd4b-9048-43bc-97ef-0d7dec369b42", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/messages/c506cd4b-9048-43bc-97ef-0d7dec369b42", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
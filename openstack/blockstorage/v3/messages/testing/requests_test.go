@@ -0,0 +1,94 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/messages"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	listOpts := messages.ListOpts{
+		ResourceType: messages.ResourceTypeVolume,
+		ResourceUUID: VolumeID,
+	}
+	allPages, err := messages.List(client.ServiceClient(), listOpts).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := messages.ExtractMessages(allPages)
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, []messages.Message{Message}, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := messages.Get(context.TODO(), client.ServiceClient(), Message.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Message, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := messages.Delete(context.TODO(), client.ServiceClient(), Message.ID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestLatest(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLatestSuccessfully(t)
+
+	sc := client.ServiceClient()
+	sc.Type = "volume"
+	sc.Microversion = "3.0"
+
+	actual, err := messages.Latest(context.TODO(), sc, messages.ResourceTypeVolume, VolumeID)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Message, *actual)
+
+	// The microversion of the client is left untouched.
+	th.AssertEquals(t, "3.0", sc.Microversion)
+}
+
+func TestAttachLatest(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLatestSuccessfully(t)
+
+	sc := client.ServiceClient()
+	sc.Type = "volume"
+
+	cause := errors.New("volume is in error")
+	err := messages.AttachLatest(context.TODO(), sc, messages.ResourceTypeVolume, VolumeID, cause)
+
+	var errWithMessage messages.ErrWithMessage
+	th.AssertEquals(t, true, errors.As(err, &errWithMessage))
+	th.CheckDeepEquals(t, Message, errWithMessage.Message)
+	th.AssertEquals(t, true, errors.Is(err, cause))
+	th.AssertEquals(t, "volume is in error: "+Message.UserMessage, err.Error())
+}
+
+func TestAttachLatestWithoutMessage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	// The messages API is unavailable: the error is returned unchanged.
+	cause := errors.New("volume is in error")
+	err := messages.AttachLatest(context.TODO(), client.ServiceClient(), messages.ResourceTypeVolume, VolumeID, cause)
+	th.AssertEquals(t, cause, err)
+
+	th.AssertNoErr(t, messages.AttachLatest(context.TODO(), client.ServiceClient(), messages.ResourceTypeVolume, VolumeID, nil))
+}
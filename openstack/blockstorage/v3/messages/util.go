@@ -0,0 +1,86 @@
+package messages
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// filterMicroversion is the first microversion supporting the filtering and
+// sorting of messages.
+const filterMicroversion = "3.5"
+
+// Latest returns the most recent message recorded about the resource with the
+// provided type and ID, or nil if there is none. The request is made with
+// microversion 3.5 if the client uses an older one.
+func Latest(ctx context.Context, client *gophercloud.ServiceClient, resourceType, resourceID string) (*Message, error) {
+	listOpts := ListOpts{
+		ResourceType: resourceType,
+		ResourceUUID: resourceID,
+		Sort:         "created_at:desc",
+		Limit:        1,
+	}
+
+	var latest *Message
+	err := List(filterClient(client), listOpts).EachPage(ctx, func(_ context.Context, page pagination.Page) (bool, error) {
+		messages, err := ExtractMessages(page)
+		if err != nil {
+			return false, err
+		}
+		if len(messages) > 0 {
+			latest = &messages[0]
+		}
+		return false, nil
+	})
+	return latest, err
+}
+
+// filterClient returns client, or a copy of it using filterMicroversion if
+// client uses an older microversion.
+func filterClient(client *gophercloud.ServiceClient) *gophercloud.ServiceClient {
+	if client.Microversion != "" {
+		major, minor, err := utils.ParseMicroversion(client.Microversion)
+		if err != nil || major > 3 || (major == 3 && minor >= 5) {
+			return client
+		}
+	}
+	c := *client
+	c.Microversion = filterMicroversion
+	return &c
+}
+
+// AttachLatest attaches the most recent message recorded about the resource
+// with the provided type and ID to err, as an ErrWithMessage. err is returned
+// unchanged if it is nil, or if no message could be found.
+func AttachLatest(ctx context.Context, client *gophercloud.ServiceClient, resourceType, resourceID string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	message, merr := Latest(ctx, client, resourceType, resourceID)
+	if merr != nil || message == nil {
+		return err
+	}
+	return ErrWithMessage{Err: err, Message: *message}
+}
+
+// ErrWithMessage is an error about a resource of the Block Storage service,
+// along with the most recent message recorded about the resource, which
+// usually explains why an asynchronous operation failed.
+type ErrWithMessage struct {
+	gophercloud.BaseError
+	Err     error
+	Message Message
+}
+
+func (e ErrWithMessage) Error() string {
+	return fmt.Sprintf("%s: %s", e.Err, e.Message.UserMessage)
+}
+
+// Unwrap returns the error the message was attached to.
+func (e ErrWithMessage) Unwrap() error {
+	return e.Err
+}
@@ -57,5 +57,13 @@ Example to update a Snapshot
 		panic(err)
 	}
 	fmt.Println(snapshot)
+
+Example to unmanage a Snapshot
+
+	snapshotID := "4a584cae-e4ce-429b-9154-d4c9eb8fda4c"
+	err := snapshots.Unmanage(context.TODO(), client, snapshotID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
 */
 package snapshots
@@ -0,0 +1,20 @@
+package snapshots
+
+import (
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrStatus is returned by WaitForStatus when the snapshot lands in an error
+// status instead of the expected one.
+type ErrStatus struct {
+	gophercloud.BaseError
+	ID       string
+	Status   string
+	Expected string
+}
+
+func (e ErrStatus) Error() string {
+	return fmt.Sprintf("Snapshot %s is in status %s while waiting for status %s", e.ID, e.Status, e.Expected)
+}
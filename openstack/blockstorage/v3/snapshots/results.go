@@ -156,3 +156,8 @@ type UpdateStatusResult struct {
 type ForceDeleteResult struct {
 	gophercloud.ErrResult
 }
+
+// UnmanageResult contains the response error from an Unmanage request.
+type UnmanageResult struct {
+	gophercloud.ErrResult
+}
@@ -228,3 +228,18 @@ func MockForceDeleteResponse(t *testing.T) {
 		w.WriteHeader(http.StatusAccepted)
 	})
 }
+
+// MockUnmanageResponse provides mock response for unmanage snapshot API call
+func MockUnmanageResponse(t *testing.T) {
+	th.Mux.HandleFunc("/snapshots/d32019d3-bc6e-4319-9c1d-6722fc136a22/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+  "os-unmanage": {}
+}
+    `)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
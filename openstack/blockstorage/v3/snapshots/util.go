@@ -2,11 +2,17 @@ package snapshots
 
 import (
 	"context"
+	"strings"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/messages"
 )
 
 // WaitForStatus will continually poll the resource, checking for a particular status.
+//
+// It fails as soon as the snapshot lands in an error status other than the
+// expected one, with an ErrStatus to which the latest message recorded about
+// the snapshot is attached as a messages.ErrWithMessage.
 func WaitForStatus(ctx context.Context, c *gophercloud.ServiceClient, id, status string) error {
 	return gophercloud.WaitFor(ctx, func(ctx context.Context) (bool, error) {
 		current, err := Get(ctx, c, id).Extract()
@@ -18,6 +24,11 @@ func WaitForStatus(ctx context.Context, c *gophercloud.ServiceClient, id, status
 			return true, nil
 		}
 
+		if strings.HasPrefix(current.Status, "error") {
+			err := ErrStatus{ID: id, Status: current.Status, Expected: status}
+			return false, messages.AttachLatest(ctx, c, messages.ResourceTypeSnapshot, id, err)
+		}
+
 		return false, nil
 	})
 }
@@ -136,6 +136,32 @@ Example of Changing Type of a Volume
 		panic(err)
 	}
 
+Example of Migrating a Volume to Another Host
+
+	migrateOpts := volumes.MigrateOpts{
+		Host: "cinder-2@lvm#LVM",
+	}
+
+	err = volumes.Migrate(context.TODO(), client, volumeID, migrateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Retyping a Volume and Waiting for its Migration
+
+	moveOpts := volumes.MoveOpts{
+		NewType:         "ssd",
+		MigrationPolicy: volumes.MigrationPolicyOnDemand,
+		OnProgress: func(v volumes.Volume) {
+			fmt.Printf("%s: %s %s\n", v.ID, v.Status, v.MigrationStatus)
+		},
+	}
+
+	volume, err := volumes.Move(context.TODO(), client, volumeID, moveOpts)
+	if err != nil {
+		panic(err)
+	}
+
 Example of Attaching a Volume to an Instance
 
 	attachOpts := volumes.AttachOpts{
@@ -157,5 +183,12 @@ Example of Attaching a Volume to an Instance
 	if err != nil {
 		panic(err)
 	}
+
+Example of Unmanaging a Volume
+
+	err := volumes.Unmanage(context.TODO(), client, volumeID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
 */
 package volumes
@@ -0,0 +1,35 @@
+package volumes
+
+import (
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrStatus is returned by WaitForStatus when the volume lands in an error
+// status instead of the expected one.
+type ErrStatus struct {
+	gophercloud.BaseError
+	ID       string
+	Status   string
+	Expected string
+}
+
+func (e ErrStatus) Error() string {
+	return fmt.Sprintf("Volume %s is in status %s while waiting for status %s", e.ID, e.Status, e.Expected)
+}
+
+// ErrMove is returned by Move when a volume could not be retyped or
+// migrated.
+type ErrMove struct {
+	gophercloud.BaseError
+	ID              string
+	Status          string
+	MigrationStatus string
+	VolumeType      string
+	Host            string
+}
+
+func (e ErrMove) Error() string {
+	return fmt.Sprintf("Volume %s could not be moved: status %s, migration status %q, type %s, host %s", e.ID, e.Status, e.MigrationStatus, e.VolumeType, e.Host)
+}
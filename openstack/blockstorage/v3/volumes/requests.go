@@ -6,6 +6,7 @@ import (
 	"regexp"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
@@ -697,6 +698,96 @@ func ChangeType(ctx context.Context, client *gophercloud.ServiceClient, id strin
 	return
 }
 
+// MigrateOptsBuilder allows extensions to add additional parameters to the
+// Migrate request.
+type MigrateOptsBuilder interface {
+	ToVolumeMigrateMap() (map[string]any, error)
+}
+
+// MigrateOpts contains options for migrating an existing Volume to another
+// backend. This object is passed to the volumes.Migrate function.
+type MigrateOpts struct {
+	// Host is the backend to migrate the volume to, in the form
+	// "host@backend#pool". As of microversion 3.16, the scheduler picks a
+	// backend if neither Host nor Cluster is set.
+	Host string `json:"host,omitempty"`
+
+	// Cluster is the cluster to migrate the volume to. It requires
+	// microversion 3.16 or later.
+	Cluster string `json:"cluster,omitempty"`
+
+	// ForceHostCopy copies the data through the host instead of using the
+	// driver optimizations.
+	ForceHostCopy bool `json:"force_host_copy,omitempty"`
+
+	// LockVolume prevents other processes from aborting the migration.
+	LockVolume bool `json:"lock_volume,omitempty"`
+}
+
+// ToVolumeMigrateMap assembles a request body based on the contents of a
+// MigrateOpts.
+func (opts MigrateOpts) ToVolumeMigrateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "os-migrate_volume")
+}
+
+// Migrate will migrate the volume to another backend. The migration is
+// asynchronous: follow it through the MigrationStatus of the volume, or use
+// Move to wait for it. MigrateResult contains only the error. To extract it,
+// call the ExtractErr method on the MigrateResult.
+func Migrate(ctx context.Context, client *gophercloud.ServiceClient, id string, opts MigrateOptsBuilder) (r MigrateResult) {
+	b, err := opts.ToVolumeMigrateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// MigrateCompletionOptsBuilder allows extensions to add additional parameters
+// to the MigrateCompletion request.
+type MigrateCompletionOptsBuilder interface {
+	ToVolumeMigrateCompletionMap() (map[string]any, error)
+}
+
+// MigrateCompletionOpts contains options for completing the migration of a
+// Volume. This object is passed to the volumes.MigrateCompletion function.
+type MigrateCompletionOpts struct {
+	// NewVolume is the ID of the volume the data was migrated to.
+	NewVolume string `json:"new_volume" required:"true"`
+
+	// Error tells that the migration failed, so that the new volume is
+	// discarded.
+	Error bool `json:"error"`
+}
+
+// ToVolumeMigrateCompletionMap assembles a request body based on the
+// contents of a MigrateCompletionOpts.
+func (opts MigrateCompletionOpts) ToVolumeMigrateCompletionMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "os-migrate_volume_completion")
+}
+
+// MigrateCompletion completes the migration of a volume to another volume,
+// swapping their identities. It is called by the Compute service once it
+// copied the data of an attached volume; it is rarely needed otherwise. To
+// extract the MigrationCompletion object from the response, call the Extract
+// method on the MigrateCompletionResult.
+func MigrateCompletion(ctx context.Context, client *gophercloud.ServiceClient, id string, opts MigrateCompletionOptsBuilder) (r MigrateCompletionResult) {
+	b, err := opts.ToVolumeMigrateCompletionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, actionURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // ReImageOpts contains options for Re-image a volume.
 type ReImageOpts struct {
 	// New image id
@@ -763,3 +854,30 @@ func ResetStatus(ctx context.Context, client *gophercloud.ServiceClient, id stri
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// Unmanage removes the volume with the provided ID from the Block Storage
+// service without deleting it from the storage backend. It can be adopted
+// again with manageablevolumes.ManageExisting. UnmanageResult contains only
+// the error. To extract it, call the ExtractErr method on the UnmanageResult.
+func Unmanage(ctx context.Context, client *gophercloud.ServiceClient, id string) (r UnmanageResult) {
+	b := map[string]any{
+		"os-unmanage": struct{}{},
+	}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// IDFromName is a convenience function that returns a volume's ID given its
+// name. The lookup is filtered by name server-side and matched exactly client-
+// side.
+//
+// It returns a gophercloud.ErrResourceNotFound if no volume has that name and a
+// gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	return utils.ResolveID(ctx, List(client, ListOpts{Name: name}), ExtractVolumes, func(v Volume) (string, string) {
+		return v.Name, v.ID
+	}, "volume", name)
+}
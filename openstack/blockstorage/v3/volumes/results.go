@@ -86,6 +86,12 @@ type Volume struct {
 	Host string `json:"os-vol-host-attr:host"`
 	// TenantID is the id of the project that owns the volume.
 	TenantID string `json:"os-vol-tenant-attr:tenant_id"`
+	// MigrationStatus is the status of the migration of the volume, e.g.
+	// "migrating" or "success". It is only returned to administrators.
+	MigrationStatus string `json:"migration_status"`
+	// NameID is the ID of the volume on the backend, which differs from ID
+	// once the volume was migrated. It is only returned to administrators.
+	NameID string `json:"os-vol-mig-status-attr:name_id"`
 }
 
 // UnmarshalJSON another unmarshalling function
@@ -390,6 +396,32 @@ type ChangeTypeResult struct {
 	gophercloud.ErrResult
 }
 
+// MigrateResult contains the response error from a Migrate request.
+type MigrateResult struct {
+	gophercloud.ErrResult
+}
+
+// MigrateCompletionResult contains the response body and error from a
+// MigrateCompletion request.
+type MigrateCompletionResult struct {
+	gophercloud.Result
+}
+
+// MigrationCompletion is the result of completing the migration of a volume.
+type MigrationCompletion struct {
+	// SaveVolumeID is the ID of the volume which holds the data once the
+	// migration is over.
+	SaveVolumeID string `json:"save_volume_id"`
+}
+
+// Extract will get the MigrationCompletion object out of the
+// MigrateCompletionResult.
+func (r MigrateCompletionResult) Extract() (*MigrationCompletion, error) {
+	var s MigrationCompletion
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
 // ReImageResult contains the response body and error from a ReImage request.
 type ReImageResult struct {
 	gophercloud.ErrResult
@@ -399,3 +431,8 @@ type ReImageResult struct {
 type ResetStatusResult struct {
 	gophercloud.ErrResult
 }
+
+// UnmanageResult contains the response error from an Unmanage request.
+type UnmanageResult struct {
+	gophercloud.ErrResult
+}
@@ -543,6 +543,15 @@ func MockForceDeleteResponse(t *testing.T) {
 	})
 }
 
+func MockUnmanageResponse(t *testing.T) {
+	th.Mux.HandleFunc("/volumes/d32019d3-bc6e-4319-9c1d-6722fc136a22/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"os-unmanage": {}}`)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
 func MockSetImageMetadataResponse(t *testing.T) {
 	th.Mux.HandleFunc("/volumes/cd281d77-8217-4830-be95-9528227c105c/action", func(w http.ResponseWriter, r *http.Request) {
 		th.TestMethod(t, r, "POST")
@@ -648,3 +657,167 @@ func MockResetStatusResponse(t *testing.T) {
 			w.WriteHeader(http.StatusAccepted)
 		})
 }
+
+// MockGetErrorResponse provides mock responses for a volume which failed to
+// be created, and for the message explaining why.
+func MockGetErrorResponse(t *testing.T) {
+	th.Mux.HandleFunc("/volumes/d32019d3-bc6e-4319-9c1d-6722fc136a22", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+  "volume": {
+    "id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+    "name": "vol-001",
+    "status": "error",
+    "size": 75
+  }
+}
+    `)
+	})
+
+	th.Mux.HandleFunc("/messages", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"resource_type": "VOLUME",
+			"resource_uuid": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			"sort":          "created_at:desc",
+			"limit":         "1",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+  "messages": [
+    {
+      "id": "c506cd4b-9048-43bc-97ef-0d7dec369b42",
+      "event_id": "VOLUME_VOLUME_001_002",
+      "user_message": "create volume: Schedule allocate volume: Could not find any available weighted backend.",
+      "message_level": "ERROR",
+      "resource_type": "VOLUME",
+      "resource_uuid": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+      "created_at": "2024-03-14T10:32:27.000000",
+      "guaranteed_until": "2024-04-13T10:32:27.000000",
+      "request_id": "req-c1216709-afba-4703-a1a3-22eda88f2f5a"
+    }
+  ]
+}
+    `)
+	})
+}
+
+func MockMigrateResponse(t *testing.T) {
+	th.Mux.HandleFunc("/volumes/cd281d77-8217-4830-be95-9528227c105c/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "os-migrate_volume": {
+        "host": "cinder-2@lvm#LVM",
+        "force_host_copy": true,
+        "lock_volume": true
+    }
+}
+          `)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+func MockMigrateCompletionResponse(t *testing.T) {
+	th.Mux.HandleFunc("/volumes/cd281d77-8217-4830-be95-9528227c105c/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "os-migrate_volume_completion": {
+        "new_volume": "2f8bbd2e-1b35-4e1b-9d3b-3c4d7b5e6f70",
+        "error": false
+    }
+}
+          `)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"save_volume_id": "cd281d77-8217-4830-be95-9528227c105c"}`)
+	})
+}
+
+// MoveVolumeID is the ID of the volume moved by MockMoveResponse.
+const MoveVolumeID = "6f4bb3a0-88f4-4b63-a52e-8e3f9c3a1b2d"
+
+// MockMoveResponse provides mock responses for a volume being moved with the
+// given action, which goes through the given states. Each state is a status,
+// a migration status and a volume type, and the last one is returned
+// forever.
+func MockMoveResponse(t *testing.T, action string, states [][3]string) {
+	var polls int
+	th.Mux.HandleFunc("/volumes/"+MoveVolumeID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		state := states[min(polls, len(states)-1)]
+		polls++
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "volume": {
+    "id": %q,
+    "name": "vol-move",
+    "status": %q,
+    "migration_status": %q,
+    "volume_type": %q,
+    "os-vol-host-attr:host": "cinder-1@lvm#LVM",
+    "size": 10
+  }
+}
+    `, MoveVolumeID, state[0], state[1], state[2])
+	})
+
+	th.Mux.HandleFunc("/volumes/"+MoveVolumeID+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, action)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	th.Mux.HandleFunc("/messages", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"resource_type": "VOLUME",
+			"resource_uuid": MoveVolumeID,
+			"sort":          "created_at:desc",
+			"limit":         "1",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "messages": [
+    {
+      "id": "0a3c2b4e-7d9f-4e61-b1a8-5c6d7e8f9a0b",
+      "event_id": "VOLUME_VOLUME_001_006",
+      "user_message": "migrate volume: Driver failed to copy the volume.",
+      "message_level": "ERROR",
+      "resource_type": "VOLUME",
+      "resource_uuid": %q,
+      "created_at": "2024-03-14T11:02:10.000000",
+      "guaranteed_until": "2024-04-13T11:02:10.000000",
+      "request_id": "req-5d2c0b6e-91f3-4a1e-8c7d-2b3a4c5d6e7f"
+    }
+  ]
+}
+    `, MoveVolumeID)
+	})
+}
@@ -2,10 +2,12 @@ package testing
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/messages"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/volumes"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
@@ -463,6 +465,16 @@ func TestForceDelete(t *testing.T) {
 	th.AssertNoErr(t, res.Err)
 }
 
+func TestUnmanage(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockUnmanageResponse(t)
+
+	res := volumes.Unmanage(context.TODO(), client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22")
+	th.AssertNoErr(t, res.Err)
+}
+
 func TestSetImageMetadata(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -538,3 +550,145 @@ func TestResetStatus(t *testing.T) {
 	err := volumes.ResetStatus(context.TODO(), client.ServiceClient(), "cd281d77-8217-4830-be95-9528227c105c", options).ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestWaitForStatusError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetErrorResponse(t)
+
+	err := volumes.WaitForStatus(context.TODO(), client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22", "available")
+
+	var errStatus volumes.ErrStatus
+	th.AssertEquals(t, true, errors.As(err, &errStatus))
+	th.AssertEquals(t, "error", errStatus.Status)
+
+	var errWithMessage messages.ErrWithMessage
+	th.AssertEquals(t, true, errors.As(err, &errWithMessage))
+	th.AssertEquals(t, "VOLUME_VOLUME_001_002", errWithMessage.Message.EventID)
+	th.AssertEquals(t, "Volume d32019d3-bc6e-4319-9c1d-6722fc136a22 is in status error while waiting for status available: create volume: Schedule allocate volume: Could not find any available weighted backend.", err.Error())
+}
+
+func TestMigrate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockMigrateResponse(t)
+
+	options := volumes.MigrateOpts{
+		Host:          "cinder-2@lvm#LVM",
+		ForceHostCopy: true,
+		LockVolume:    true,
+	}
+
+	err := volumes.Migrate(context.TODO(), client.ServiceClient(), "cd281d77-8217-4830-be95-9528227c105c", options).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestMigrateCompletion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockMigrateCompletionResponse(t)
+
+	options := volumes.MigrateCompletionOpts{
+		NewVolume: "2f8bbd2e-1b35-4e1b-9d3b-3c4d7b5e6f70",
+	}
+
+	completion, err := volumes.MigrateCompletion(context.TODO(), client.ServiceClient(), "cd281d77-8217-4830-be95-9528227c105c", options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "cd281d77-8217-4830-be95-9528227c105c", completion.SaveVolumeID)
+}
+
+func TestMoveRetype(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockMoveResponse(t, `{"os-retype": {"new_type": "ssd", "migration_policy": "on-demand"}}`, [][3]string{
+		{"available", "", "lvm"},
+		{"retyping", "starting", "lvm"},
+		{"retyping", "migrating", "lvm"},
+		{"retyping", "migrating", "lvm"},
+		{"available", "success", "ssd"},
+	})
+
+	var progress []string
+	opts := volumes.MoveOpts{
+		NewType:      "ssd",
+		PollInterval: time.Millisecond,
+		OnProgress: func(v volumes.Volume) {
+			progress = append(progress, v.Status+"/"+v.MigrationStatus)
+		},
+	}
+	v, err := volumes.Move(context.TODO(), client.ServiceClient(), MoveVolumeID, opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "ssd", v.VolumeType)
+	th.AssertDeepEquals(t, []string{"retyping/starting", "retyping/migrating", "available/success"}, progress)
+}
+
+func TestMoveRetypeRefused(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockMoveResponse(t, `{"os-retype": {"new_type": "ssd", "migration_policy": "never"}}`, [][3]string{
+		{"available", "", "lvm"},
+		{"retyping", "", "lvm"},
+		{"available", "", "lvm"},
+	})
+
+	opts := volumes.MoveOpts{
+		NewType:         "ssd",
+		MigrationPolicy: volumes.MigrationPolicyNever,
+		PollInterval:    time.Millisecond,
+	}
+	v, err := volumes.Move(context.TODO(), client.ServiceClient(), MoveVolumeID, opts)
+	th.AssertEquals(t, "lvm", v.VolumeType)
+
+	var errMove volumes.ErrMove
+	th.AssertEquals(t, true, errors.As(err, &errMove))
+	th.AssertEquals(t, "available", errMove.Status)
+}
+
+func TestMoveMigrateError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockMoveResponse(t, `{"os-migrate_volume": {"host": "cinder-2@lvm#LVM"}}`, [][3]string{
+		{"in-use", "", "lvm"},
+		{"in-use", "starting", "lvm"},
+		{"in-use", "migrating", "lvm"},
+		{"in-use", "error", "lvm"},
+	})
+
+	opts := volumes.MoveOpts{
+		Host:         "cinder-2@lvm#LVM",
+		PollInterval: time.Millisecond,
+	}
+	_, err := volumes.Move(context.TODO(), client.ServiceClient(), MoveVolumeID, opts)
+
+	var errWithMessage messages.ErrWithMessage
+	th.AssertEquals(t, true, errors.As(err, &errWithMessage))
+	th.AssertEquals(t, "VOLUME_VOLUME_001_006", errWithMessage.Message.EventID)
+	th.AssertEquals(t, `Volume 6f4bb3a0-88f4-4b63-a52e-8e3f9c3a1b2d could not be moved: status in-use, migration status "error", type lvm, host cinder-1@lvm#LVM: migrate volume: Driver failed to copy the volume.`, err.Error())
+}
+
+func TestMoveRetypeAfterFailedMigration(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	// An earlier migration failed, and the retype does not migrate the
+	// volume, so its migration status stays "error".
+	MockMoveResponse(t, `{"os-retype": {"new_type": "ssd", "migration_policy": "on-demand"}}`, [][3]string{
+		{"available", "error", "lvm"},
+		{"retyping", "error", "lvm"},
+		{"available", "error", "ssd"},
+	})
+
+	opts := volumes.MoveOpts{
+		NewType:      "ssd",
+		PollInterval: time.Millisecond,
+	}
+	v, err := volumes.Move(context.TODO(), client.ServiceClient(), MoveVolumeID, opts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "ssd", v.VolumeType)
+}
@@ -2,11 +2,18 @@ package volumes
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/messages"
 )
 
 // WaitForStatus will continually poll the resource, checking for a particular status.
+//
+// It fails as soon as the volume lands in an error status other than the
+// expected one, with an ErrStatus to which the latest message recorded about
+// the volume is attached as a messages.ErrWithMessage.
 func WaitForStatus(ctx context.Context, c *gophercloud.ServiceClient, id, status string) error {
 	return gophercloud.WaitFor(ctx, func(ctx context.Context) (bool, error) {
 		current, err := Get(ctx, c, id).Extract()
@@ -18,6 +25,142 @@ func WaitForStatus(ctx context.Context, c *gophercloud.ServiceClient, id, status
 			return true, nil
 		}
 
+		if strings.HasPrefix(current.Status, "error") {
+			err := ErrStatus{ID: id, Status: current.Status, Expected: status}
+			return false, messages.AttachLatest(ctx, c, messages.ResourceTypeVolume, id, err)
+		}
+
 		return false, nil
 	})
 }
+
+// DefaultMovePollInterval is the time between two checks of a volume being
+// moved when MoveOpts.PollInterval is not set.
+const DefaultMovePollInterval = 5 * time.Second
+
+// MoveOpts configures Move.
+type MoveOpts struct {
+	// NewType is the volume type to retype the volume to. When it is empty,
+	// the volume is migrated with Host and Cluster instead.
+	NewType string
+
+	// MigrationPolicy tells whether the volume may be migrated to another
+	// backend to be retyped. Defaults to MigrationPolicyOnDemand.
+	MigrationPolicy MigrationPolicy
+
+	// Host, Cluster, ForceHostCopy and LockVolume are passed to Migrate when
+	// NewType is empty.
+	Host          string
+	Cluster       string
+	ForceHostCopy bool
+	LockVolume    bool
+
+	// PollInterval is the time between two checks of the volume. Defaults to
+	// DefaultMovePollInterval.
+	PollInterval time.Duration
+
+	// OnProgress is called with the volume each time its status or
+	// migration status changes.
+	OnProgress func(Volume)
+}
+
+// migrating tells whether a migration of the volume is in progress.
+func migrating(v *Volume) bool {
+	switch v.MigrationStatus {
+	case "starting", "migrating", "completing":
+		return true
+	}
+	return false
+}
+
+// moving tells whether a retype or a migration of the volume is in progress.
+func moving(v *Volume) bool {
+	return migrating(v) || v.Status == "retyping" || v.Status == "maintenance"
+}
+
+// Move retypes a volume when opts.NewType is set, migrating it to another
+// backend if allowed by opts.MigrationPolicy, or migrates it to opts.Host
+// otherwise. It then waits until the status and the migration status of the
+// volume settle, and returns the moved volume.
+//
+// A retype is successful once the type of the volume changed, and a
+// migration once its migration status is "success". A migration status of
+// "error" left by an earlier migration is ignored unless this call started a
+// migration. The migration status
+// is only returned to administrators; others can only retype volumes without
+// migration. On failure, Move returns an ErrMove to which the latest message
+// recorded about the volume is attached as a messages.ErrWithMessage, along
+// with the volume as last seen.
+func Move(ctx context.Context, client *gophercloud.ServiceClient, id string, opts MoveOpts) (*Volume, error) {
+	v, err := Get(ctx, client, id).Extract()
+	if err != nil {
+		return nil, err
+	}
+	oldType := v.VolumeType
+
+	if opts.NewType != "" {
+		policy := opts.MigrationPolicy
+		if policy == "" {
+			policy = MigrationPolicyOnDemand
+		}
+		err = ChangeType(ctx, client, id, ChangeTypeOpts{NewType: opts.NewType, MigrationPolicy: policy}).ExtractErr()
+	} else {
+		migrateOpts := MigrateOpts{
+			Host:          opts.Host,
+			Cluster:       opts.Cluster,
+			ForceHostCopy: opts.ForceHostCopy,
+			LockVolume:    opts.LockVolume,
+		}
+		err = Migrate(ctx, client, id, migrateOpts).ExtractErr()
+	}
+	if err != nil {
+		return v, err
+	}
+
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = DefaultMovePollInterval
+	}
+
+	// A retype only migrates the volume when needed, which shows in its
+	// migration status.
+	started := opts.NewType == ""
+	var lastStatus, lastMigrationStatus string
+	for {
+		v, err = Get(ctx, client, id).Extract()
+		if err != nil {
+			return nil, err
+		}
+
+		if opts.OnProgress != nil && (v.Status != lastStatus || v.MigrationStatus != lastMigrationStatus) {
+			opts.OnProgress(*v)
+		}
+		lastStatus, lastMigrationStatus = v.Status, v.MigrationStatus
+		started = started || migrating(v)
+
+		switch {
+		case opts.NewType != "" && v.VolumeType != oldType && !moving(v):
+			return v, nil
+		case opts.NewType == "" && v.MigrationStatus == "success":
+			return v, nil
+		case started && v.MigrationStatus == "error", strings.HasPrefix(v.Status, "error"):
+			// The move failed.
+		case moving(v):
+			select {
+			case <-time.After(interval):
+				continue
+			case <-ctx.Done():
+				return v, ctx.Err()
+			}
+		}
+
+		err := ErrMove{
+			ID:              id,
+			Status:          v.Status,
+			MigrationStatus: v.MigrationStatus,
+			VolumeType:      v.VolumeType,
+			Host:            v.Host,
+		}
+		return v, messages.AttachLatest(ctx, client, messages.ResourceTypeVolume, id, err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
@@ -70,9 +71,29 @@ type ListOptsBuilder interface {
 	ToVolumeTypeListQuery() (string, error)
 }
 
+// Visibility maps to the is_public query parameter of a Volume Type listing.
+// Although is_public is a boolean, the query is ternary, which is why
+// Visibility is a string.
+type Visibility string
+
+const (
+	// VisibilityDefault (admin only) returns both public and private Volume
+	// Types.
+	VisibilityDefault Visibility = "None"
+
+	// VisibilityPublic returns only public Volume Types.
+	VisibilityPublic Visibility = "true"
+
+	// VisibilityPrivate returns only private Volume Types.
+	VisibilityPrivate Visibility = "false"
+)
+
 // ListOpts holds options for listing Volume Types. It is passed to the volumetypes.List
 // function.
 type ListOpts struct {
+	// IsPublic, if provided, instructs List which set of Volume Types to
+	// return. If it is not provided, only public Volume Types are returned.
+	IsPublic Visibility `q:"is_public"`
 	// Comma-separated list of sort keys and optional sort directions in the
 	// form of <key>[:<direction>].
 	Sort string `q:"sort"`
@@ -411,3 +432,15 @@ func UpdateEncryption(ctx context.Context, client *gophercloud.ServiceClient, id
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// IDFromName is a convenience function that returns a volume type's ID given
+// its name. The API has no name filter, so every volume type, public or
+// private, is listed and matched client-side.
+//
+// It returns a gophercloud.ErrResourceNotFound if no volume type has that name
+// and a gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	return utils.ResolveID(ctx, List(client, ListOpts{IsPublic: VisibilityDefault}), ExtractVolumeTypes, func(vt VolumeType) (string, string) {
+		return vt.Name, vt.ID
+	}, "volume type", name)
+}
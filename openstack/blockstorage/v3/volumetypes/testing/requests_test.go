@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/volumetypes"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
@@ -373,3 +374,55 @@ func TestGetEncryptionSpec(t *testing.T) {
 		t.Fatalf("Key %s does not exist in map.", key)
 	}
 }
+
+func handleVolumeTypeIDFromName(t *testing.T) {
+	th.Mux.HandleFunc("/types", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"is_public": "None"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `
+			{
+				"volume_types": [
+					{"id": "6685584b-1eac-4da6-b5c3-555430cf68ff", "name": "SSD", "is_public": true},
+					{"id": "8eb69a46-df97-4e41-9586-9a40a7533803", "name": "SATA", "is_public": true},
+					{"id": "2f7bbd21-9d34-4a3c-b54c-fbb1a1a5ecb3", "name": "SATA", "is_public": false},
+					{"id": "c3e8e1f6-6b2d-4a27-8c5b-9a1f3e0d4b7a", "name": "replicated", "is_public": false}
+				]
+			}
+		`)
+	})
+}
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleVolumeTypeIDFromName(t)
+
+	id, err := volumetypes.IDFromName(context.TODO(), client.ServiceClient(), "replicated")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "c3e8e1f6-6b2d-4a27-8c5b-9a1f3e0d4b7a", id)
+}
+
+func TestIDFromNameNotFound(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleVolumeTypeIDFromName(t)
+
+	_, err := volumetypes.IDFromName(context.TODO(), client.ServiceClient(), "NVMe")
+	if _, ok := err.(gophercloud.ErrResourceNotFound); !ok {
+		t.Fatalf("Expected ErrResourceNotFound, got %v", err)
+	}
+}
+
+func TestIDFromNameMultipleFound(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleVolumeTypeIDFromName(t)
+
+	_, err := volumetypes.IDFromName(context.TODO(), client.ServiceClient(), "SATA")
+	if _, ok := err.(gophercloud.ErrMultipleResourcesFound); !ok {
+		t.Fatalf("Expected ErrMultipleResourcesFound, got %v", err)
+	}
+}
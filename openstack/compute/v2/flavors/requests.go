@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
@@ -364,3 +365,15 @@ func DeleteExtraSpec(ctx context.Context, client *gophercloud.ServiceClient, fla
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// IDFromName is a convenience function that returns a flavor's ID given its
+// name. The API has no name filter, so every flavor is listed and matched
+// client-side. Private flavors the caller has access to are listed too.
+//
+// It returns a gophercloud.ErrResourceNotFound if no flavor has that name and a
+// gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	return utils.ResolveID(ctx, ListDetail(client, ListOpts{AccessType: AllAccess}), ExtractFlavors, func(f Flavor) (string, string) {
+		return f.Name, f.ID
+	}, "flavor", name)
+}
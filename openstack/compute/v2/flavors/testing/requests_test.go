@@ -464,3 +464,28 @@ func TestFlavorExtraSpecDelete(t *testing.T) {
 	res := flavors.DeleteExtraSpec(context.TODO(), fake.ServiceClient(), "1", "hw:cpu_policy")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/flavors/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"is_public": "None"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `
+			{
+				"flavors": [
+					{"id": "1", "name": "m1.tiny", "os-flavor-access:is_public": true},
+					{"id": "3", "name": "m1.private", "os-flavor-access:is_public": false}
+				]
+			}
+		`)
+	})
+
+	id, err := flavors.IDFromName(context.TODO(), fake.ServiceClient(), "m1.private")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "3", id)
+}
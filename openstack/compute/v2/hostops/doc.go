@@ -0,0 +1,71 @@
+/*
+Package hostops provides operations on whole compute hosts, such as draining
+a host of its servers before maintenance.
+
+Example to Drain a Host
+
+	computeClient.Microversion = "2.53"
+
+	drainOpts := hostops.DrainOpts{
+		DisabledReason:      "kernel upgrade",
+		Concurrency:         4,
+		ColdMigrateFallback: true,
+	}
+
+	report, err := hostops.Drain(context.TODO(), computeClient, "compute-01", drainOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, outcome := range report.Outcomes {
+		fmt.Printf("%s: %s (%s)\n", outcome.ServerID, outcome.Status, outcome.Method)
+	}
+
+	if err := report.Err(); err != nil {
+		panic(err)
+	}
+
+Example to Evacuate a Host which is Down
+
+	computeClient.Microversion = "2.53"
+
+	drainOpts := hostops.DrainOpts{
+		Evacuate: true,
+	}
+
+	report, err := hostops.Drain(context.TODO(), computeClient, "compute-01", drainOpts)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Save the Progress of a Drain and Resume It
+
+	computeClient.Microversion = "2.53"
+
+	report, err := hostops.Drain(ctx, computeClient, "compute-01", hostops.DrainOpts{})
+	if report != nil {
+		b, _ := json.Marshal(report)
+		os.WriteFile("drain-compute-01.json", b, 0o600)
+	}
+
+	// Later, once the drain was interrupted.
+	b, err := os.ReadFile("drain-compute-01.json")
+	if err != nil {
+		panic(err)
+	}
+
+	var previous hostops.Report
+	if err := json.Unmarshal(b, &previous); err != nil {
+		panic(err)
+	}
+
+	drainOpts := hostops.DrainOpts{
+		Resume: &previous,
+	}
+
+	report, err = hostops.Drain(context.TODO(), computeClient, "compute-01", drainOpts)
+	if err != nil {
+		panic(err)
+	}
+*/
+package hostops
@@ -0,0 +1,445 @@
+package hostops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/batch"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/hypervisors"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/services"
+)
+
+const (
+	// DefaultConcurrency is the number of servers moved at once when
+	// DrainOpts.Concurrency is not set.
+	DefaultConcurrency = 2
+
+	// DefaultPollInterval is the time between two checks of a moving
+	// server when DrainOpts.PollInterval is not set.
+	DefaultPollInterval = 5 * time.Second
+
+	// DefaultDisabledReason is the reason the compute service is disabled
+	// with when DrainOpts.DisabledReason is not set.
+	DefaultDisabledReason = "maintenance"
+
+	// DefaultHypervisorTimeout is the time the hypervisor of a drained host
+	// is given to stop reporting running servers when
+	// DrainOpts.HypervisorTimeout is not set. The compute service refreshes
+	// the count once a minute by default.
+	DefaultHypervisorTimeout = 2 * time.Minute
+)
+
+// inProgress are the server statuses of an operation which is still
+// running.
+var inProgress = map[string]bool{
+	"MIGRATING": true,
+	"RESIZE":    true,
+	"REBUILD":   true,
+	"BUILD":     true,
+}
+
+// DrainOpts configures Drain.
+type DrainOpts struct {
+	// DisabledReason is recorded on the compute service when it is
+	// disabled. Defaults to DefaultDisabledReason.
+	DisabledReason string
+
+	// Concurrency is the number of servers moved at once. Defaults to
+	// DefaultConcurrency.
+	Concurrency int
+
+	// BlockMigration is passed to servers.LiveMigrate.
+	BlockMigration *bool
+
+	// ColdMigrateFallback cold migrates the running servers which could not
+	// be live migrated. Such servers are rebooted.
+	ColdMigrateFallback bool
+
+	// Evacuate allows draining a host whose compute service is down, by
+	// evacuating its servers. Otherwise, Drain returns an ErrHostDown.
+	Evacuate bool
+
+	// EvacuateOpts is passed to servers.Evacuate. Defaults to
+	// servers.EvacuateOpts{}.
+	EvacuateOpts servers.EvacuateOptsBuilder
+
+	// PollInterval is the time between two checks of a moving server.
+	// Defaults to DefaultPollInterval.
+	PollInterval time.Duration
+
+	// HypervisorTimeout is the time the hypervisor of the host is given to
+	// stop reporting running servers once no server is listed on the host.
+	// Defaults to DefaultHypervisorTimeout.
+	HypervisorTimeout time.Duration
+
+	// Resume is the report of an earlier, interrupted drain of the host.
+	// Servers it reports as moved are not handled again, and servers it
+	// reports otherwise are handled even if they are no longer listed on
+	// the host, e.g. because a cold migration awaits confirmation.
+	Resume *Report
+
+	// OnOutcome is called once each server is handled, e.g. to save the
+	// progress of the drain. It may be called concurrently.
+	OnOutcome func(Outcome)
+}
+
+type drainer struct {
+	client   *gophercloud.ServiceClient
+	host     string
+	hostDown bool
+	opts     DrainOpts
+	interval time.Duration
+}
+
+// Drain empties a compute host before maintenance. It disables the
+// nova-compute service of the host, moves every server off the host and
+// checks that none is left, both in the server list and in the running_vms
+// count of the hypervisor of the host. That count is refreshed periodically,
+// so Drain waits up to opts.HypervisorTimeout for it to drop to zero. It is
+// not returned as of microversion 2.88, nor refreshed while the compute
+// service is down, in which cases only the server list is checked.
+//
+// Running servers are live migrated and stopped ones cold migrated. If the
+// compute service is down, servers are evacuated instead. The compute
+// service is left disabled.
+//
+// The returned error is only set if the host could not be drained at all;
+// the outcome of each server is recorded in the Report. Drain requires
+// microversion 2.53 or later.
+func Drain(ctx context.Context, client *gophercloud.ServiceClient, host string, opts DrainOpts) (*Report, error) {
+	report := &Report{Host: host}
+
+	service, err := findService(ctx, client, host)
+	if err != nil {
+		return report, err
+	}
+	report.ServiceID = service.ID
+	report.HostDown = service.State == "down"
+
+	if service.Status != string(services.ServiceDisabled) {
+		reason := opts.DisabledReason
+		if reason == "" {
+			reason = DefaultDisabledReason
+		}
+		updateOpts := services.UpdateOpts{
+			Status:         services.ServiceDisabled,
+			DisabledReason: reason,
+		}
+		if _, err := services.Update(ctx, client, service.ID, updateOpts).Extract(); err != nil {
+			return report, fmt.Errorf("unable to disable the compute service of host %s: %w", host, err)
+		}
+	}
+
+	if report.HostDown && !opts.Evacuate {
+		return report, ErrHostDown{Host: host}
+	}
+
+	onHost, err := listServers(ctx, client, host)
+	if err != nil {
+		return report, err
+	}
+
+	// Carry over the servers already moved, and look up the ones an earlier
+	// drain left halfway.
+	listed := make(map[string]bool, len(onHost))
+	for _, s := range onHost {
+		listed[s.ID] = true
+	}
+	var pending []Outcome
+	if opts.Resume != nil {
+		for _, o := range opts.Resume.Outcomes {
+			switch {
+			case listed[o.ServerID]:
+			case o.Status == StatusMoved:
+				report.Outcomes = append(report.Outcomes, o)
+			default:
+				pending = append(pending, Outcome{ServerID: o.ServerID, Name: o.Name})
+			}
+		}
+	}
+	for _, s := range onHost {
+		pending = append(pending, Outcome{ServerID: s.ID, Name: s.Name})
+	}
+
+	d := &drainer{
+		client:   client,
+		host:     host,
+		hostDown: report.HostDown,
+		opts:     opts,
+		interval: opts.PollInterval,
+	}
+	if d.interval <= 0 {
+		d.interval = DefaultPollInterval
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	ops := make([]batch.Operation, len(pending))
+	for i := range pending {
+		ops[i] = batch.Operation{
+			Key:    pending[i].ServerID,
+			Client: client,
+			Do: func(ctx context.Context, _ *gophercloud.ServiceClient) error {
+				d.move(ctx, &pending[i])
+				if opts.OnOutcome != nil {
+					opts.OnOutcome(pending[i])
+				}
+				return pending[i].Err
+			},
+		}
+	}
+	results := batch.Run(ctx, ops, batch.Opts{Concurrency: concurrency})
+	for i, res := range results.Results {
+		if res.Skipped {
+			pending[i].Status, pending[i].Err = StatusSkipped, ctx.Err()
+		}
+	}
+	report.Outcomes = append(report.Outcomes, pending...)
+
+	left, err := listServers(ctx, client, host)
+	if err != nil {
+		return report, err
+	}
+	for _, s := range left {
+		report.Remaining = append(report.Remaining, s.ID)
+	}
+
+	// The compute service of a host which is down no longer refreshes the
+	// running_vms count of its hypervisor, so it is not checked.
+	if !report.HostDown {
+		report.RunningVMs, err = d.runningVMs(ctx, len(left) == 0)
+		if err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// runningVMs returns the number of servers the hypervisor of the host runs.
+// If wait is set, it polls the hypervisor until the count drops to zero or
+// opts.HypervisorTimeout elapses.
+func (d *drainer) runningVMs(ctx context.Context, wait bool) (int, error) {
+	timeout := d.opts.HypervisorTimeout
+	if timeout <= 0 {
+		timeout = DefaultHypervisorTimeout
+	}
+	deadline := time.Now().Add(timeout)
+
+	for {
+		n, err := hypervisorVMs(ctx, d.client, d.host)
+		if err != nil || n == 0 || !wait || time.Now().After(deadline) {
+			return n, err
+		}
+
+		select {
+		case <-time.After(d.interval):
+		case <-ctx.Done():
+			return n, ctx.Err()
+		}
+	}
+}
+
+// hypervisorVMs returns the running_vms count of the hypervisors of a host.
+func hypervisorVMs(ctx context.Context, client *gophercloud.ServiceClient, host string) (int, error) {
+	listOpts := hypervisors.ListOpts{
+		HypervisorHostnamePattern: &host,
+	}
+	pages, err := hypervisors.List(client, listOpts).AllPages(ctx)
+	if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		// No hypervisor hostname matches the host.
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("unable to list the hypervisors of host %s: %w", host, err)
+	}
+	all, err := hypervisors.ExtractHypervisors(pages)
+	if err != nil {
+		return 0, err
+	}
+
+	var n int
+	for _, h := range all {
+		if h.Service.Host == host {
+			n += h.RunningVMs
+		}
+	}
+	return n, nil
+}
+
+// move moves a single server off the host and records the outcome in o.
+func (d *drainer) move(ctx context.Context, o *Outcome) {
+	s, err := d.settle(ctx, o.ServerID)
+	if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		o.Status, o.Err = StatusSkipped, err
+		return
+	}
+	if err != nil {
+		o.Status, o.Err = StatusFailed, err
+		return
+	}
+
+	switch {
+	case s.Status == "VERIFY_RESIZE":
+		o.Method = MethodConfirmResize
+		s, err = d.confirmResize(ctx, s.ID)
+	case s.Host != d.host:
+	case d.hostDown:
+		o.Method = MethodEvacuate
+		s, err = d.evacuate(ctx, s.ID)
+	case s.Status == "ACTIVE" || s.Status == "PAUSED":
+		o.Method = MethodLiveMigrate
+		s, err = d.liveMigrate(ctx, s.ID)
+		if d.opts.ColdMigrateFallback && s != nil && s.Status == "ACTIVE" && s.Host == d.host {
+			o.Method, o.FellBack = MethodColdMigrate, true
+			s, err = d.coldMigrate(ctx, s.ID)
+		}
+	case s.Status == "SHUTOFF":
+		o.Method = MethodColdMigrate
+		s, err = d.coldMigrate(ctx, s.ID)
+	default:
+		o.Status, o.Err = StatusSkipped, fmt.Errorf("server %s is %s and cannot be moved", s.ID, s.Status)
+		return
+	}
+
+	switch {
+	case err != nil:
+		o.Status, o.Err = StatusFailed, err
+	case s.Host == d.host:
+		o.Status, o.Err = StatusFailed, fmt.Errorf("server %s is still on host %s with status %s", s.ID, d.host, s.Status)
+	default:
+		o.Status, o.Err, o.Destination = StatusMoved, nil, s.Host
+	}
+}
+
+// liveMigrate live migrates a server and waits for the migration to be
+// over. A server which could not be live migrated is returned as well, so
+// that it can be cold migrated if it is still running on the host: along
+// with the 400 or 409 error if the request was refused, or with a nil error
+// if the migration itself failed.
+func (d *drainer) liveMigrate(ctx context.Context, id string) (*servers.Server, error) {
+	liveOpts := servers.LiveMigrateOpts{
+		BlockMigration: d.opts.BlockMigration,
+	}
+	err := servers.LiveMigrate(ctx, d.client, id, liveOpts).ExtractErr()
+	if gophercloud.ResponseCodeIs(err, http.StatusBadRequest) || gophercloud.ResponseCodeIs(err, http.StatusConflict) {
+		// The server cannot be live migrated, e.g. because of its devices.
+		s, getErr := servers.Get(ctx, d.client, id).Extract()
+		if getErr != nil {
+			return nil, err
+		}
+		return s, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return d.settle(ctx, id)
+}
+
+// coldMigrate cold migrates a server and confirms the migration.
+func (d *drainer) coldMigrate(ctx context.Context, id string) (*servers.Server, error) {
+	if err := servers.Migrate(ctx, d.client, id).ExtractErr(); err != nil {
+		return nil, err
+	}
+	s, err := d.settle(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s.Status != "VERIFY_RESIZE" {
+		// The migration failed, or was confirmed automatically.
+		return s, nil
+	}
+	return d.confirmResize(ctx, id)
+}
+
+// confirmResize confirms the cold migration of a server.
+func (d *drainer) confirmResize(ctx context.Context, id string) (*servers.Server, error) {
+	if err := servers.ConfirmResize(ctx, d.client, id).ExtractErr(); err != nil {
+		return nil, err
+	}
+	return d.settleConfirmed(ctx, id)
+}
+
+// evacuate rebuilds a server of a host which is down on another host.
+func (d *drainer) evacuate(ctx context.Context, id string) (*servers.Server, error) {
+	var evacuateOpts servers.EvacuateOptsBuilder = servers.EvacuateOpts{}
+	if d.opts.EvacuateOpts != nil {
+		evacuateOpts = d.opts.EvacuateOpts
+	}
+	if err := servers.Evacuate(ctx, d.client, id, evacuateOpts).Err; err != nil {
+		return nil, err
+	}
+	return d.settle(ctx, id)
+}
+
+// settle waits until no operation is running on a server.
+func (d *drainer) settle(ctx context.Context, id string) (*servers.Server, error) {
+	return d.poll(ctx, id, func(s *servers.Server) bool {
+		return s.TaskState == "" && !inProgress[s.Status]
+	})
+}
+
+// settleConfirmed waits until the confirmation of a cold migration is over.
+func (d *drainer) settleConfirmed(ctx context.Context, id string) (*servers.Server, error) {
+	return d.poll(ctx, id, func(s *servers.Server) bool {
+		return s.TaskState == "" && !inProgress[s.Status] && s.Status != "VERIFY_RESIZE"
+	})
+}
+
+func (d *drainer) poll(ctx context.Context, id string, done func(*servers.Server) bool) (*servers.Server, error) {
+	for {
+		s, err := servers.Get(ctx, d.client, id).Extract()
+		if err != nil {
+			return nil, err
+		}
+		if done(s) {
+			return s, nil
+		}
+
+		select {
+		case <-time.After(d.interval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// findService returns the nova-compute service of a host.
+func findService(ctx context.Context, client *gophercloud.ServiceClient, host string) (*services.Service, error) {
+	listOpts := services.ListOpts{
+		Binary: "nova-compute",
+		Host:   host,
+	}
+	pages, err := services.List(client, listOpts).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	all, err := services.ExtractServices(pages)
+	if err != nil {
+		return nil, err
+	}
+	if len(all) == 0 {
+		return nil, gophercloud.ErrResourceNotFound{Name: host, ResourceType: "nova-compute service"}
+	}
+	return &all[0], nil
+}
+
+// listServers returns the servers of every project on a host.
+func listServers(ctx context.Context, client *gophercloud.ServiceClient, host string) ([]servers.Server, error) {
+	listOpts := servers.ListOpts{
+		AllTenants: true,
+		Host:       host,
+	}
+	pages, err := servers.List(client, listOpts).AllPages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the servers of host %s: %w", host, err)
+	}
+	return servers.ExtractServers(pages)
+}
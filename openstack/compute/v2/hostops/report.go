@@ -0,0 +1,188 @@
+package hostops
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// Method is the way a server was moved off a host.
+type Method string
+
+const (
+	// MethodLiveMigrate moves a running server without downtime.
+	MethodLiveMigrate Method = "live-migrate"
+
+	// MethodColdMigrate moves a server through a migration which is then
+	// confirmed, rebooting it if it is running.
+	MethodColdMigrate Method = "cold-migrate"
+
+	// MethodConfirmResize confirms a cold migration started by an earlier,
+	// interrupted drain.
+	MethodConfirmResize Method = "confirm-resize"
+
+	// MethodEvacuate rebuilds the server of a host which is down on another
+	// host.
+	MethodEvacuate Method = "evacuate"
+)
+
+// Status is the outcome of moving a single server.
+type Status string
+
+const (
+	// StatusMoved is reported for servers which are no longer on the host.
+	StatusMoved Status = "moved"
+
+	// StatusFailed is reported for servers which could not be moved.
+	StatusFailed Status = "failed"
+
+	// StatusSkipped is reported for servers which were not moved, either
+	// because their status does not allow it or because the drain was
+	// interrupted before their turn.
+	StatusSkipped Status = "skipped"
+)
+
+// Outcome is the result of moving a single server.
+type Outcome struct {
+	ServerID string
+	Name     string
+	Status   Status
+
+	// Method is the last method used to move the server.
+	Method Method
+
+	// FellBack is true if the server could not be live migrated and was
+	// cold migrated instead.
+	FellBack bool
+
+	// Destination is the host the server was moved to.
+	Destination string
+
+	Err error
+}
+
+type jsonOutcome struct {
+	ServerID    string `json:"server_id"`
+	Name        string `json:"name,omitempty"`
+	Status      Status `json:"status"`
+	Method      Method `json:"method,omitempty"`
+	FellBack    bool   `json:"fell_back,omitempty"`
+	Destination string `json:"destination,omitempty"`
+	Err         string `json:"error,omitempty"`
+}
+
+// MarshalJSON encodes the outcome, including the message of its error, so
+// that a Report can be saved and passed to a later drain as
+// DrainOpts.Resume.
+func (o Outcome) MarshalJSON() ([]byte, error) {
+	j := jsonOutcome{
+		ServerID:    o.ServerID,
+		Name:        o.Name,
+		Status:      o.Status,
+		Method:      o.Method,
+		FellBack:    o.FellBack,
+		Destination: o.Destination,
+	}
+	if o.Err != nil {
+		j.Err = o.Err.Error()
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes an outcome encoded by MarshalJSON.
+func (o *Outcome) UnmarshalJSON(b []byte) error {
+	var j jsonOutcome
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	*o = Outcome{
+		ServerID:    j.ServerID,
+		Name:        j.Name,
+		Status:      j.Status,
+		Method:      j.Method,
+		FellBack:    j.FellBack,
+		Destination: j.Destination,
+	}
+	if j.Err != "" {
+		o.Err = errors.New(j.Err)
+	}
+	return nil
+}
+
+// Report holds the outcome of every server of a drained host.
+type Report struct {
+	Host string `json:"host"`
+
+	// ServiceID is the ID of the nova-compute service of the host.
+	ServiceID string `json:"service_id"`
+
+	// HostDown is true if the compute service of the host was down, in
+	// which case its servers were evacuated.
+	HostDown bool `json:"host_down"`
+
+	Outcomes []Outcome `json:"outcomes"`
+
+	// Remaining are the IDs of the servers found on the host once every
+	// server was handled.
+	Remaining []string `json:"remaining"`
+
+	// RunningVMs is the number of servers the hypervisor of the host still
+	// reported running at the end of the drain. It is not checked if the
+	// host was down.
+	RunningVMs int `json:"running_vms"`
+}
+
+// Failed returns the outcomes of servers which could not be moved.
+func (r Report) Failed() []Outcome {
+	var s []Outcome
+	for _, o := range r.Outcomes {
+		if o.Status == StatusFailed {
+			s = append(s, o)
+		}
+	}
+	return s
+}
+
+// Err returns an ErrDrain if the host is not empty, and nil otherwise.
+func (r Report) Err() error {
+	var failed, skipped int
+	for _, o := range r.Outcomes {
+		switch o.Status {
+		case StatusFailed:
+			failed++
+		case StatusSkipped:
+			skipped++
+		}
+	}
+	if failed == 0 && skipped == 0 && len(r.Remaining) == 0 && r.RunningVMs == 0 {
+		return nil
+	}
+	return ErrDrain{Host: r.Host, Failed: failed, Skipped: skipped, Remaining: len(r.Remaining), RunningVMs: r.RunningVMs}
+}
+
+// ErrDrain is returned by Report.Err when a host could not be emptied.
+type ErrDrain struct {
+	gophercloud.BaseError
+	Host       string
+	Failed     int
+	Skipped    int
+	Remaining  int
+	RunningVMs int
+}
+
+func (e ErrDrain) Error() string {
+	return fmt.Sprintf("Unable to drain host %s: %d servers failed, %d skipped, %d remaining, %d running on the hypervisor", e.Host, e.Failed, e.Skipped, e.Remaining, e.RunningVMs)
+}
+
+// ErrHostDown is returned by Drain when the compute service of the host is
+// down and DrainOpts.Evacuate is not set.
+type ErrHostDown struct {
+	gophercloud.BaseError
+	Host string
+}
+
+func (e ErrHostDown) Error() string {
+	return fmt.Sprintf("The compute service of host %s is down; its servers can only be evacuated", e.Host)
+}
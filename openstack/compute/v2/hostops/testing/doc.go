@@ -0,0 +1,2 @@
+// Package testing includes hostops unit tests
+package testing
@@ -0,0 +1,258 @@
+package testing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/hostops"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func outcomesByServer(report *hostops.Report) map[string]hostops.Outcome {
+	m := make(map[string]hostops.Outcome)
+	for _, o := range report.Outcomes {
+		m[o.ServerID] = o
+	}
+	return m
+}
+
+func TestDrain(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	cloud := &fakeCloud{
+		serviceState: "up",
+		servers: []*fakeServer{
+			{ID: "web", serverState: serverState{Status: "ACTIVE", Host: "compute1"}, Destination: "compute2"},
+			{ID: "db", serverState: serverState{Status: "SHUTOFF", Host: "compute1"}, Destination: "compute3"},
+			{ID: "gpu", serverState: serverState{Status: "ACTIVE", Host: "compute1"}, Destination: "compute4", LiveMigrateCode: 400},
+			{ID: "broken", serverState: serverState{Status: "ERROR", Host: "compute1"}},
+			{ID: "other", serverState: serverState{Status: "ACTIVE", Host: "compute9"}},
+		},
+	}
+	HandleCloud(t, cloud)
+
+	var mu sync.Mutex
+	var done []string
+	drainOpts := hostops.DrainOpts{
+		DisabledReason:      "kernel upgrade",
+		ColdMigrateFallback: true,
+		PollInterval:        time.Millisecond,
+		OnOutcome: func(o hostops.Outcome) {
+			mu.Lock()
+			defer mu.Unlock()
+			done = append(done, o.ServerID)
+		},
+	}
+
+	report, err := hostops.Drain(context.TODO(), client.ServiceClient(), "compute1", drainOpts)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, serviceID, report.ServiceID)
+	th.AssertEquals(t, false, report.HostDown)
+	th.AssertEquals(t, 4, len(report.Outcomes))
+	slices.Sort(done)
+	th.AssertDeepEquals(t, []string{"broken", "db", "gpu", "web"}, done)
+
+	outcomes := outcomesByServer(report)
+	th.AssertEquals(t, hostops.StatusMoved, outcomes["web"].Status)
+	th.AssertEquals(t, hostops.MethodLiveMigrate, outcomes["web"].Method)
+	th.AssertEquals(t, "compute2", outcomes["web"].Destination)
+
+	th.AssertEquals(t, hostops.StatusMoved, outcomes["db"].Status)
+	th.AssertEquals(t, hostops.MethodColdMigrate, outcomes["db"].Method)
+	th.AssertEquals(t, "compute3", outcomes["db"].Destination)
+
+	th.AssertEquals(t, hostops.StatusMoved, outcomes["gpu"].Status)
+	th.AssertEquals(t, hostops.MethodColdMigrate, outcomes["gpu"].Method)
+	th.AssertEquals(t, true, outcomes["gpu"].FellBack)
+
+	th.AssertEquals(t, hostops.StatusSkipped, outcomes["broken"].Status)
+	th.AssertDeepEquals(t, []string{"broken"}, report.Remaining)
+	th.AssertEquals(t, 1, report.RunningVMs)
+
+	var errDrain hostops.ErrDrain
+	th.AssertEquals(t, true, errors.As(report.Err(), &errDrain))
+	th.AssertEquals(t, 1, errDrain.Skipped)
+	th.AssertEquals(t, 1, errDrain.Remaining)
+	th.AssertEquals(t, 1, errDrain.RunningVMs)
+
+	actions := cloud.Actions()
+	th.AssertEquals(t, "service disable", actions[0])
+	slices.Sort(actions)
+	th.AssertDeepEquals(t, []string{
+		"db confirmResize",
+		"db migrate",
+		"gpu confirmResize",
+		"gpu migrate",
+		"gpu os-migrateLive",
+		"service disable",
+		"web os-migrateLive",
+	}, actions)
+}
+
+func TestDrainLiveMigrationFails(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	cloud := &fakeCloud{
+		serviceState: "up",
+		serviceOff:   true,
+		servers: []*fakeServer{
+			{ID: "web", serverState: serverState{Status: "ACTIVE", Host: "compute1"}, Destination: "compute2", LiveMigrateFails: true},
+		},
+	}
+	HandleCloud(t, cloud)
+
+	report, err := hostops.Drain(context.TODO(), client.ServiceClient(), "compute1", hostops.DrainOpts{PollInterval: time.Millisecond})
+	th.AssertNoErr(t, err)
+
+	// The service was disabled already, and there is no fallback.
+	th.AssertDeepEquals(t, []string{"web os-migrateLive"}, cloud.Actions())
+	th.AssertEquals(t, 1, len(report.Failed()))
+	th.AssertEquals(t, hostops.MethodLiveMigrate, report.Failed()[0].Method)
+	th.AssertDeepEquals(t, []string{"web"}, report.Remaining)
+	th.AssertErr(t, report.Err())
+}
+
+func TestDrainHostDown(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	cloud := &fakeCloud{
+		serviceState: "down",
+		StaleVMs:     3,
+		servers: []*fakeServer{
+			{ID: "web", serverState: serverState{Status: "ACTIVE", Host: "compute1"}, Destination: "compute2"},
+			{ID: "db", serverState: serverState{Status: "SHUTOFF", Host: "compute1"}, Destination: "compute3"},
+		},
+	}
+	HandleCloud(t, cloud)
+
+	drainOpts := hostops.DrainOpts{
+		DisabledReason:    "kernel upgrade",
+		PollInterval:      time.Millisecond,
+		HypervisorTimeout: 5 * time.Millisecond,
+	}
+	report, err := hostops.Drain(context.TODO(), client.ServiceClient(), "compute1", drainOpts)
+	var errHostDown hostops.ErrHostDown
+	th.AssertEquals(t, true, errors.As(err, &errHostDown))
+	th.AssertEquals(t, true, report.HostDown)
+	th.AssertDeepEquals(t, []string{"service disable"}, cloud.Actions())
+
+	drainOpts.Evacuate = true
+	report, err = hostops.Drain(context.TODO(), client.ServiceClient(), "compute1", drainOpts)
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, report.Err())
+	th.AssertEquals(t, 0, report.RunningVMs)
+
+	for _, o := range report.Outcomes {
+		th.AssertEquals(t, hostops.StatusMoved, o.Status)
+		th.AssertEquals(t, hostops.MethodEvacuate, o.Method)
+	}
+}
+
+func TestDrainResume(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	// The previous drain moved web, and was interrupted while db was
+	// waiting for its cold migration to be confirmed.
+	cloud := &fakeCloud{
+		serviceState: "up",
+		serviceOff:   true,
+		servers: []*fakeServer{
+			{ID: "web", serverState: serverState{Status: "ACTIVE", Host: "compute2"}},
+			{ID: "db", serverState: serverState{Status: "VERIFY_RESIZE", Host: "compute3"}, statusBefore: "SHUTOFF"},
+			{ID: "app", serverState: serverState{Status: "ACTIVE", Host: "compute1"}, Destination: "compute2"},
+		},
+	}
+	HandleCloud(t, cloud)
+
+	previous := hostops.Report{
+		Host:      "compute1",
+		ServiceID: serviceID,
+		Outcomes: []hostops.Outcome{
+			{ServerID: "web", Status: hostops.StatusMoved, Method: hostops.MethodLiveMigrate, Destination: "compute2"},
+			{ServerID: "db", Status: hostops.StatusSkipped, Err: context.Canceled},
+			{ServerID: "app", Status: hostops.StatusSkipped, Err: context.Canceled},
+		},
+	}
+
+	// The report is saved and loaded in between.
+	b, err := json.Marshal(previous)
+	th.AssertNoErr(t, err)
+	var resume hostops.Report
+	th.AssertNoErr(t, json.Unmarshal(b, &resume))
+	th.AssertEquals(t, context.Canceled.Error(), resume.Outcomes[1].Err.Error())
+
+	drainOpts := hostops.DrainOpts{
+		PollInterval: time.Millisecond,
+		Resume:       &resume,
+	}
+	report, err := hostops.Drain(context.TODO(), client.ServiceClient(), "compute1", drainOpts)
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, report.Err())
+
+	outcomes := outcomesByServer(report)
+	th.AssertEquals(t, 3, len(outcomes))
+	th.AssertEquals(t, hostops.MethodLiveMigrate, outcomes["web"].Method)
+	th.AssertEquals(t, hostops.MethodConfirmResize, outcomes["db"].Method)
+	th.AssertEquals(t, "compute3", outcomes["db"].Destination)
+	th.AssertEquals(t, hostops.MethodLiveMigrate, outcomes["app"].Method)
+
+	actions := cloud.Actions()
+	slices.Sort(actions)
+	th.AssertDeepEquals(t, []string{"app os-migrateLive", "db confirmResize"}, actions)
+}
+
+func TestDrainWaitsForHypervisor(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	cloud := &fakeCloud{
+		serviceState: "up",
+		serviceOff:   true,
+		servers: []*fakeServer{
+			{ID: "web", serverState: serverState{Status: "ACTIVE", Host: "compute1"}, Destination: "compute2"},
+		},
+		StaleVMs: 2,
+	}
+	HandleCloud(t, cloud)
+
+	report, err := hostops.Drain(context.TODO(), client.ServiceClient(), "compute1", hostops.DrainOpts{PollInterval: time.Millisecond})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, report.RunningVMs)
+	th.AssertNoErr(t, report.Err())
+}
+
+func TestDrainHypervisorStillRunning(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	cloud := &fakeCloud{
+		serviceState: "up",
+		serviceOff:   true,
+		StaleVMs:     1000,
+	}
+	HandleCloud(t, cloud)
+
+	drainOpts := hostops.DrainOpts{
+		PollInterval:      time.Millisecond,
+		HypervisorTimeout: 5 * time.Millisecond,
+	}
+	report, err := hostops.Drain(context.TODO(), client.ServiceClient(), "compute1", drainOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(report.Remaining))
+	th.AssertEquals(t, true, report.RunningVMs > 0)
+
+	var errDrain hostops.ErrDrain
+	th.AssertEquals(t, true, errors.As(report.Err(), &errDrain))
+	th.AssertEquals(t, report.RunningVMs, errDrain.RunningVMs)
+}
@@ -0,0 +1,221 @@
+package testing
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const serviceID = "1fdfec3e-ee03-4e36-b99b-71cf2967b70c"
+
+// serverState is the state of a fake server as returned by the API.
+type serverState struct {
+	Status    string
+	Host      string
+	TaskState string
+}
+
+// fakeServer is a server of a fakeCloud. Actions move it to a transitional
+// state, returned by the next Get, and then to their final state.
+type fakeServer struct {
+	ID   string
+	Name string
+	serverState
+
+	// LiveMigrateCode is the status code of a live migration request. The
+	// migration is accepted when it is zero.
+	LiveMigrateCode int
+
+	// LiveMigrateFails leaves the server on its host once the live
+	// migration is over.
+	LiveMigrateFails bool
+
+	// Destination is the host the server is moved to.
+	Destination string
+
+	next         *serverState
+	statusBefore string
+}
+
+// fakeCloud simulates the compute API of a host being drained.
+type fakeCloud struct {
+	t            *testing.T
+	mu           sync.Mutex
+	serviceState string
+	serviceOff   bool
+	servers      []*fakeServer
+	actions      []string
+
+	// StaleVMs is the number of servers the hypervisor of the host keeps
+	// reporting once they are gone. It decreases by one on each poll while
+	// the compute service is up.
+	StaleVMs int
+}
+
+func (c *fakeCloud) server(id string) *fakeServer {
+	for _, s := range c.servers {
+		if s.ID == id {
+			return s
+		}
+	}
+	return nil
+}
+
+// Actions returns the actions run, as "<server> <action>" strings.
+func (c *fakeCloud) Actions() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.actions...)
+}
+
+func serverJSON(s *fakeServer, state serverState) map[string]any {
+	return map[string]any{
+		"id":                    s.ID,
+		"name":                  s.Name,
+		"status":                state.Status,
+		"OS-EXT-SRV-ATTR:host":  state.Host,
+		"OS-EXT-STS:task_state": state.TaskState,
+	}
+}
+
+// HandleCloud sets up the test server to simulate c.
+func HandleCloud(t *testing.T, c *fakeCloud) {
+	c.t = t
+
+	th.Mux.HandleFunc("/os-services", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"binary": "nova-compute", "host": "compute1"})
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		status := "enabled"
+		if c.serviceOff {
+			status = "disabled"
+		}
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"services": [{"id": "%s", "binary": "nova-compute", "host": "compute1", "state": "%s", "status": "%s"}]}`, serviceID, c.serviceState, status)
+	})
+
+	th.Mux.HandleFunc("/os-services/"+serviceID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"status": "disabled", "disabled_reason": "kernel upgrade"}`)
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.serviceOff = true
+		c.actions = append(c.actions, "service disable")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"service": {"id": "%s", "binary": "nova-compute", "host": "compute1", "state": "%s", "status": "disabled"}}`, serviceID, c.serviceState)
+	})
+
+	th.Mux.HandleFunc("/os-hypervisors/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"hypervisor_hostname_pattern": "compute1"})
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		running := c.StaleVMs
+		if c.StaleVMs > 0 && c.serviceState != "down" {
+			c.StaleVMs--
+		}
+		for _, s := range c.servers {
+			if s.Host == "compute1" {
+				running++
+			}
+		}
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"hypervisors": [
+			{"id": "c48f6247-abe4-4a24-824e-ea39e108874f", "hypervisor_hostname": "compute1.example.com", "hypervisor_version": 2002000, "running_vms": %d, "service": {"id": "%s", "host": "compute1"}},
+			{"id": "3a8d5b5e-5b0b-4d6e-8f42-a7b1b2c3d4e5", "hypervisor_hostname": "compute10.example.com", "hypervisor_version": 2002000, "running_vms": 7, "service": {"id": "a1b2c3d4-0000-4000-8000-000000000010", "host": "compute10"}}
+		]}`, running, serviceID)
+	})
+
+	th.Mux.HandleFunc("/servers/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"all_tenants": "true", "host": "compute1"})
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		list := []map[string]any{}
+		for _, s := range c.servers {
+			if s.Host == "compute1" {
+				list = append(list, serverJSON(s, s.serverState))
+			}
+		}
+		w.Header().Add("Content-Type", "application/json")
+		th.AssertNoErr(t, json.NewEncoder(w).Encode(map[string]any{"servers": list}))
+	})
+
+	th.Mux.HandleFunc("/servers/", func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/servers/"), "/")
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		s := c.server(parts[0])
+		if s == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if len(parts) == 1 {
+			th.TestMethod(t, r, "GET")
+			state := s.serverState
+			if s.next != nil {
+				state, s.next = *s.next, nil
+			}
+			w.Header().Add("Content-Type", "application/json")
+			th.AssertNoErr(t, json.NewEncoder(w).Encode(map[string]any{"server": serverJSON(s, state)}))
+			return
+		}
+
+		th.TestMethod(t, r, "POST")
+		var body map[string]any
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+		for action := range body {
+			c.actions = append(c.actions, s.ID+" "+action)
+			c.act(w, s, action)
+		}
+	})
+}
+
+func (c *fakeCloud) act(w http.ResponseWriter, s *fakeServer, action string) {
+	switch action {
+	case "os-migrateLive":
+		if s.LiveMigrateCode != 0 {
+			w.WriteHeader(s.LiveMigrateCode)
+			return
+		}
+		s.next = &serverState{Status: "MIGRATING", Host: s.Host, TaskState: "migrating"}
+		if !s.LiveMigrateFails {
+			s.Host = s.Destination
+		}
+	case "migrate":
+		s.next = &serverState{Status: "RESIZE", Host: s.Host, TaskState: "resize_migrating"}
+		s.statusBefore = s.Status
+		s.Status, s.Host = "VERIFY_RESIZE", s.Destination
+	case "confirmResize":
+		s.next = &serverState{Status: "VERIFY_RESIZE", Host: s.Host, TaskState: "resize_confirming"}
+		s.Status = s.statusBefore
+		if s.Status == "" {
+			s.Status = "ACTIVE"
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case "evacuate":
+		s.next = &serverState{Status: "REBUILD", Host: s.Host, TaskState: "rebuilding"}
+		s.Host = s.Destination
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+		return
+	default:
+		c.t.Errorf("Unexpected action %s", action)
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
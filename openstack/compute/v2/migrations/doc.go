@@ -0,0 +1,89 @@
+/*
+Package migrations provides the ability to list the migrations of servers,
+and to monitor and intervene on in-progress live migrations.
+
+Example to List Live Migrations from a Host
+
+	computeClient.Microversion = "2.59"
+
+	listOpts := migrations.ListOpts{
+		Host:          "compute-01",
+		MigrationType: migrations.TypeLiveMigration,
+	}
+
+	allPages, err := migrations.List(computeClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allMigrations, err := migrations.ExtractMigrations(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, migration := range allMigrations {
+		fmt.Printf("%+v\n", migration)
+	}
+
+Example to List the In-Progress Live Migrations of a Server
+
+	computeClient.Microversion = "2.23"
+
+	allPages, err := migrations.ListByServer(computeClient, serverID).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allMigrations, err := migrations.ExtractServerMigrations(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, migration := range allMigrations {
+		fmt.Printf("%d: %d bytes of memory left\n", migration.ID, migration.MemoryRemainingBytes)
+	}
+
+Example to Force a Live Migration to Complete
+
+	computeClient.Microversion = "2.22"
+
+	err := migrations.ForceComplete(context.TODO(), computeClient, serverID, migrationID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Abort a Live Migration
+
+	computeClient.Microversion = "2.24"
+
+	err := migrations.Abort(context.TODO(), computeClient, serverID, migrationID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Watch a Live Migration
+
+	computeClient.Microversion = "2.24"
+
+	watchOpts := migrations.WatchOpts{
+		Interval: 10 * time.Second,
+		Policy: migrations.ThresholdPolicy{
+			ForceCompleteAbove: 95,
+			AbortAfterStalled:  12,
+			AbortAfter:         30 * time.Minute,
+		},
+		OnProgress: func(p migrations.Progress) {
+			fmt.Printf("memory %.0f%%, disk %.0f%%\n", p.MemoryPercent(), p.DiskPercent())
+		},
+	}
+
+	result, err := migrations.Watch(context.TODO(), computeClient, serverID, migrationID, watchOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	if result.Migration != nil {
+		fmt.Printf("migration %s after %v\n", result.Migration.Status, result.Actions)
+	}
+*/
+package migrations
@@ -0,0 +1,137 @@
+package migrations
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToMigrationListQuery() (string, error)
+}
+
+// ListOpts represents options used to filter migrations in a List request.
+type ListOpts struct {
+	// Host filters the response by the source or destination compute
+	// service of the migration.
+	Host string `q:"host"`
+
+	// InstanceUUID filters the response by the server being migrated.
+	InstanceUUID string `q:"instance_uuid"`
+
+	// SourceCompute filters the response by the source compute service.
+	SourceCompute string `q:"source_compute"`
+
+	// Status filters the response by the status of the migration.
+	Status string `q:"status"`
+
+	// MigrationType filters the response by the type of the migration, such
+	// as TypeLiveMigration.
+	// This requires microversion 2.23 or later.
+	MigrationType string `q:"migration_type"`
+
+	// Limit is an integer value to limit the results to return.
+	// This requires microversion 2.59 or later.
+	Limit int `q:"limit"`
+
+	// Marker is the UUID of the last-seen migration.
+	// This requires microversion 2.59 or later.
+	Marker string `q:"marker"`
+
+	// ChangesSince filters the response by migrations updated after the
+	// given time.
+	// This requires microversion 2.59 or later.
+	ChangesSince *time.Time `q:"changes-since"`
+
+	// ChangesBefore filters the response by migrations updated before the
+	// given time.
+	// This requires microversion 2.66 or later.
+	ChangesBefore *time.Time `q:"changes-before"`
+
+	// UserID filters the response by the user which started the migration.
+	// This requires microversion 2.80 or later.
+	UserID string `q:"user_id"`
+
+	// ProjectID filters the response by the project of the migrated server.
+	// This requires microversion 2.80 or later.
+	ProjectID string `q:"project_id"`
+}
+
+// ToMigrationListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToMigrationListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	params := q.Query()
+
+	if opts.ChangesSince != nil {
+		params.Add("changes-since", opts.ChangesSince.Format(time.RFC3339))
+	}
+
+	if opts.ChangesBefore != nil {
+		params.Add("changes-before", opts.ChangesBefore.Format(time.RFC3339))
+	}
+
+	q = &url.URL{RawQuery: params.Encode()}
+	return q.String(), nil
+}
+
+// List makes a request against the API to list the migrations of all
+// servers, including completed ones.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToMigrationListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return MigrationPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListByServer makes a request against the API to list the in-progress live
+// migrations of a server.
+// This requires microversion 2.23 or later.
+func ListByServer(client *gophercloud.ServiceClient, serverID string) pagination.Pager {
+	return pagination.NewPager(client, listByServerURL(client, serverID), func(r pagination.PageResult) pagination.Page {
+		return ServerMigrationPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get makes a request against the API to get an in-progress live migration
+// of a server. A 404 is returned once the migration is over.
+// This requires microversion 2.23 or later.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, serverID string, migrationID int) (r GetResult) {
+	resp, err := client.Get(ctx, serverMigrationURL(client, serverID, migrationID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ForceComplete forces an in-progress live migration to complete, by pausing
+// the server on the source host until the migration is done.
+// This requires microversion 2.22 or later.
+func ForceComplete(ctx context.Context, client *gophercloud.ServiceClient, serverID string, migrationID int) (r ActionResult) {
+	b := map[string]any{"force_complete": nil}
+	resp, err := client.Post(ctx, actionURL(client, serverID, migrationID), b, nil, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Abort cancels an in-progress live migration, leaving the server on the
+// source host.
+// This requires microversion 2.24 or later.
+func Abort(ctx context.Context, client *gophercloud.ServiceClient, serverID string, migrationID int) (r ActionResult) {
+	resp, err := client.Delete(ctx, serverMigrationURL(client, serverID, migrationID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
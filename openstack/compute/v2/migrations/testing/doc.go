@@ -0,0 +1,2 @@
+// Package testing includes migrations unit tests
+package testing
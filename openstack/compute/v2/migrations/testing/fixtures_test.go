@@ -0,0 +1,255 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/migrations"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const serverID = "4cfba335-03d8-49b2-8c52-e69043d1e8fe"
+
+// ListFirstPage is the first page of a List response, with a link to the
+// second one.
+const ListFirstPage = `
+{
+    "migrations": [
+        {
+            "created_at": "2016-01-29T13:42:02.000000",
+            "dest_compute": "compute2",
+            "dest_host": "1.2.3.4",
+            "dest_node": "node2",
+            "id": 1234,
+            "instance_uuid": "4cfba335-03d8-49b2-8c52-e69043d1e8fe",
+            "new_instance_type_id": 1,
+            "old_instance_type_id": 1,
+            "source_compute": "compute1",
+            "source_node": "node1",
+            "status": "running",
+            "migration_type": "live-migration",
+            "updated_at": "2016-01-29T13:42:02.000000",
+            "uuid": "42341d4b-346a-40d0-83c6-5f4f6892b650"
+        }
+    ],
+    "migrations_links": [
+        {
+            "href": "%s/os-migrations?limit=1&marker=42341d4b-346a-40d0-83c6-5f4f6892b650",
+            "rel": "next"
+        }
+    ]
+}
+`
+
+// ListSecondPage is the second and last page of a List response.
+const ListSecondPage = `
+{
+    "migrations": [
+        {
+            "created_at": "2016-01-22T13:42:02.000000",
+            "dest_compute": "compute20",
+            "dest_host": "5.6.7.8",
+            "dest_node": "node20",
+            "id": 5678,
+            "instance_uuid": "3a7a6a9c-2b7e-4a8f-b5a5-0f6d3d0a0a0b",
+            "new_instance_type_id": 6,
+            "old_instance_type_id": 5,
+            "source_compute": "compute10",
+            "source_node": "node10",
+            "status": "confirmed",
+            "migration_type": "resize",
+            "updated_at": "2016-01-22T13:43:02.000000",
+            "uuid": "12341d4b-346a-40d0-83c6-5f4f6892b650"
+        }
+    ]
+}
+`
+
+// ListByServerResponse is a ListByServer response.
+const ListByServerResponse = `
+{
+    "migrations": [
+        {
+            "created_at": "2016-01-29T13:42:02.000000",
+            "dest_compute": "compute2",
+            "dest_host": "1.2.3.4",
+            "dest_node": "node2",
+            "id": 1234,
+            "server_uuid": "4cfba335-03d8-49b2-8c52-e69043d1e8fe",
+            "source_compute": "compute1",
+            "source_node": "node1",
+            "status": "running",
+            "memory_total_bytes": 123456,
+            "memory_processed_bytes": 12345,
+            "memory_remaining_bytes": 111111,
+            "disk_total_bytes": 234567,
+            "disk_processed_bytes": 23456,
+            "disk_remaining_bytes": 211111,
+            "updated_at": "2016-01-29T13:42:02.000000",
+            "uuid": "42341d4b-346a-40d0-83c6-5f4f6892b650"
+        }
+    ]
+}
+`
+
+// GetResponse is a Get response.
+const GetResponse = `
+{
+    "migration": {
+        "created_at": "2016-01-29T13:42:02.000000",
+        "dest_compute": "compute2",
+        "dest_host": "1.2.3.4",
+        "dest_node": "node2",
+        "id": 1234,
+        "server_uuid": "4cfba335-03d8-49b2-8c52-e69043d1e8fe",
+        "source_compute": "compute1",
+        "source_node": "node1",
+        "status": "running",
+        "memory_total_bytes": 123456,
+        "memory_processed_bytes": 12345,
+        "memory_remaining_bytes": 111111,
+        "disk_total_bytes": 234567,
+        "disk_processed_bytes": 23456,
+        "disk_remaining_bytes": 211111,
+        "updated_at": "2016-01-29T13:42:02.000000",
+        "uuid": "42341d4b-346a-40d0-83c6-5f4f6892b650"
+    }
+}
+`
+
+var (
+	// FirstMigration is the migration of ListFirstPage.
+	FirstMigration = migrations.Migration{
+		ID:                1234,
+		UUID:              "42341d4b-346a-40d0-83c6-5f4f6892b650",
+		InstanceUUID:      serverID,
+		MigrationType:     migrations.TypeLiveMigration,
+		Status:            migrations.StatusRunning,
+		SourceCompute:     "compute1",
+		SourceNode:        "node1",
+		DestCompute:       "compute2",
+		DestHost:          "1.2.3.4",
+		DestNode:          "node2",
+		OldInstanceTypeID: 1,
+		NewInstanceTypeID: 1,
+		CreatedAt:         time.Date(2016, 1, 29, 13, 42, 2, 0, time.UTC),
+		UpdatedAt:         time.Date(2016, 1, 29, 13, 42, 2, 0, time.UTC),
+	}
+
+	// SecondMigration is the migration of ListSecondPage.
+	SecondMigration = migrations.Migration{
+		ID:                5678,
+		UUID:              "12341d4b-346a-40d0-83c6-5f4f6892b650",
+		InstanceUUID:      "3a7a6a9c-2b7e-4a8f-b5a5-0f6d3d0a0a0b",
+		MigrationType:     migrations.TypeResize,
+		Status:            migrations.StatusConfirmed,
+		SourceCompute:     "compute10",
+		SourceNode:        "node10",
+		DestCompute:       "compute20",
+		DestHost:          "5.6.7.8",
+		DestNode:          "node20",
+		OldInstanceTypeID: 5,
+		NewInstanceTypeID: 6,
+		CreatedAt:         time.Date(2016, 1, 22, 13, 42, 2, 0, time.UTC),
+		UpdatedAt:         time.Date(2016, 1, 22, 13, 43, 2, 0, time.UTC),
+	}
+
+	// RunningMigration is the migration of ListByServerResponse and
+	// GetResponse.
+	RunningMigration = migrations.ServerMigration{
+		ID:                   1234,
+		UUID:                 "42341d4b-346a-40d0-83c6-5f4f6892b650",
+		ServerUUID:           serverID,
+		Status:               migrations.StatusRunning,
+		SourceCompute:        "compute1",
+		SourceNode:           "node1",
+		DestCompute:          "compute2",
+		DestHost:             "1.2.3.4",
+		DestNode:             "node2",
+		MemoryTotalBytes:     123456,
+		MemoryProcessedBytes: 12345,
+		MemoryRemainingBytes: 111111,
+		DiskTotalBytes:       234567,
+		DiskProcessedBytes:   23456,
+		DiskRemainingBytes:   211111,
+		CreatedAt:            time.Date(2016, 1, 29, 13, 42, 2, 0, time.UTC),
+		UpdatedAt:            time.Date(2016, 1, 29, 13, 42, 2, 0, time.UTC),
+	}
+)
+
+// HandleListSuccessfully sets up the test server to respond to a List
+// request with two pages.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-migrations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		switch r.Form.Get("marker") {
+		case "":
+			th.TestFormValues(t, r, map[string]string{
+				"host":           "compute1",
+				"migration_type": "live-migration",
+				"changes-since":  "2016-01-29T00:00:00Z",
+				"limit":          "1",
+			})
+			fmt.Fprintf(w, ListFirstPage, th.Server.URL)
+		case "42341d4b-346a-40d0-83c6-5f4f6892b650":
+			fmt.Fprint(w, ListSecondPage)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", r.Form.Get("marker"))
+		}
+	})
+}
+
+// HandleListByServerSuccessfully sets up the test server to respond to a
+// ListByServer request.
+func HandleListByServerSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ListByServerResponse)
+	})
+}
+
+// HandleGetSuccessfully sets up the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations/1234", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetResponse)
+	})
+}
+
+// HandleForceCompleteSuccessfully sets up the test server to respond to a
+// ForceComplete request.
+func HandleForceCompleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations/1234/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"force_complete": null}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleAbortSuccessfully sets up the test server to respond to an Abort
+// request.
+func HandleAbortSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations/1234", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
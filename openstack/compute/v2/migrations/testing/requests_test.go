@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/migrations"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	changesSince := time.Date(2016, 1, 29, 0, 0, 0, 0, time.UTC)
+	listOpts := migrations.ListOpts{
+		Host:          "compute1",
+		MigrationType: migrations.TypeLiveMigration,
+		ChangesSince:  &changesSince,
+		Limit:         1,
+	}
+
+	expected := [][]migrations.Migration{{FirstMigration}, {SecondMigration}}
+	pages := 0
+	err := migrations.List(client.ServiceClient(), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		actual, err := migrations.ExtractMigrations(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, expected[pages], actual)
+
+		pages++
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 2, pages)
+}
+
+func TestListByServer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListByServerSuccessfully(t)
+
+	allPages, err := migrations.ListByServer(client.ServiceClient(), serverID).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := migrations.ExtractServerMigrations(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []migrations.ServerMigration{RunningMigration}, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := migrations.Get(context.TODO(), client.ServiceClient(), serverID, 1234).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &RunningMigration, actual)
+}
+
+func TestForceComplete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleForceCompleteSuccessfully(t)
+
+	err := migrations.ForceComplete(context.TODO(), client.ServiceClient(), serverID, 1234).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestAbort(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAbortSuccessfully(t)
+
+	err := migrations.Abort(context.TODO(), client.ServiceClient(), serverID, 1234).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,186 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/migrations"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	"github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+// handleWatch serves a migration whose remaining memory goes through
+// remaining, one value per Get, before it is over. The final record of the
+// migration has the given status.
+func handleWatch(t *testing.T, remaining []int64, status string) *[]string {
+	var calls []string
+
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations/1234", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		calls = append(calls, r.Method)
+
+		switch r.Method {
+		case "GET":
+			if len(remaining) == 0 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"migration": {"id": 1234, "server_uuid": "%s", "status": "running", "memory_total_bytes": 1000, "memory_remaining_bytes": %d}}`, serverID, remaining[0])
+			remaining = remaining[1:]
+		case "DELETE":
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations/1234/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `{"force_complete": null}`)
+		calls = append(calls, "FORCE")
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	th.Mux.HandleFunc("/os-migrations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{
+			"instance_uuid":  serverID,
+			"migration_type": "live-migration",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"migrations": [
+			{"id": 1200, "instance_uuid": "%[1]s", "status": "error", "migration_type": "live-migration"},
+			{"id": 1234, "instance_uuid": "%[1]s", "status": "%[2]s", "migration_type": "live-migration"}
+		]}`, serverID, status)
+	})
+
+	return &calls
+}
+
+func TestWatchForceComplete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := handleWatch(t, []int64{800, 500, 50, 10}, migrations.StatusCompleted)
+
+	var percents []float64
+	watchOpts := migrations.WatchOpts{
+		Interval: time.Millisecond,
+		Policy:   migrations.ThresholdPolicy{ForceCompleteAbove: 90},
+		OnProgress: func(p migrations.Progress) {
+			percents = append(percents, p.MemoryPercent())
+		},
+	}
+
+	res, err := migrations.Watch(context.TODO(), client.ServiceClient(), serverID, 1234, watchOpts)
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, []float64{20, 50, 95, 99}, percents)
+	th.AssertDeepEquals(t, []migrations.Action{migrations.ActionForceComplete}, res.Actions)
+	th.AssertDeepEquals(t, []string{"GET", "GET", "GET", "FORCE", "GET", "GET"}, *calls)
+	th.AssertEquals(t, int64(10), res.Last.MemoryRemainingBytes)
+	th.AssertEquals(t, migrations.StatusCompleted, res.Migration.Status)
+}
+
+func TestWatchAbortOnStall(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	calls := handleWatch(t, []int64{600, 400, 400, 450, 300}, migrations.StatusCancelled)
+
+	var stalled []int
+	watchOpts := migrations.WatchOpts{
+		Interval: time.Millisecond,
+		Policy:   migrations.ThresholdPolicy{AbortAfterStalled: 2, ForceCompleteAbove: 65},
+		OnProgress: func(p migrations.Progress) {
+			stalled = append(stalled, p.Stalled)
+		},
+	}
+
+	res, err := migrations.Watch(context.TODO(), client.ServiceClient(), serverID, 1234, watchOpts)
+	th.AssertNoErr(t, err)
+
+	// Nothing is done after the migration is aborted.
+	th.AssertDeepEquals(t, []int{0, 0, 1, 2, 0}, stalled)
+	th.AssertDeepEquals(t, []migrations.Action{migrations.ActionAbort}, res.Actions)
+	th.AssertDeepEquals(t, []string{"GET", "GET", "GET", "GET", "DELETE", "GET", "GET"}, *calls)
+	th.AssertEquals(t, migrations.StatusCancelled, res.Migration.Status)
+}
+
+func TestWatchOver(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleWatch(t, nil, migrations.StatusCompleted)
+
+	res, err := migrations.Watch(context.TODO(), client.ServiceClient(), serverID, 1234, migrations.WatchOpts{})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, true, res.Last == nil)
+	th.AssertEquals(t, 0, len(res.Actions))
+	th.AssertEquals(t, migrations.StatusCompleted, res.Migration.Status)
+}
+
+func TestThresholdPolicy(t *testing.T) {
+	policy := migrations.ThresholdPolicy{
+		ForceCompleteAfter: 10 * time.Minute,
+		AbortAfter:         30 * time.Minute,
+	}
+
+	th.AssertEquals(t, migrations.ActionNone, policy.Decide(migrations.Progress{Elapsed: time.Minute}))
+	th.AssertEquals(t, migrations.ActionForceComplete, policy.Decide(migrations.Progress{Elapsed: 15 * time.Minute}))
+	th.AssertEquals(t, migrations.ActionAbort, policy.Decide(migrations.Progress{Elapsed: time.Hour}))
+}
+
+func TestWatchRetriesUntilRunning(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var calls []string
+	statuses := []string{migrations.StatusPreparing, migrations.StatusRunning}
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations/1234", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		calls = append(calls, r.Method)
+
+		if len(statuses) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"migration": {"id": 1234, "server_uuid": "%s", "status": "%s", "memory_total_bytes": 1000, "memory_remaining_bytes": 500}}`, serverID, statuses[0])
+	})
+
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations/1234/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		calls = append(calls, "FORCE")
+
+		// The migration can only be forced to complete once it runs.
+		if statuses[0] != migrations.StatusRunning {
+			statuses = statuses[1:]
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		statuses = nil
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	th.Mux.HandleFunc("/os-migrations", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"migrations": [{"id": 1234, "instance_uuid": "%s", "status": "completed"}]}`, serverID)
+	})
+
+	watchOpts := migrations.WatchOpts{
+		Interval: time.Millisecond,
+		Policy:   migrations.ThresholdPolicy{ForceCompleteAbove: 10},
+	}
+	res, err := migrations.Watch(context.TODO(), client.ServiceClient(), serverID, 1234, watchOpts)
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, []migrations.Action{migrations.ActionForceComplete}, res.Actions)
+	th.AssertDeepEquals(t, []string{"GET", "FORCE", "GET", "FORCE", "GET"}, calls)
+	th.AssertEquals(t, migrations.StatusCompleted, res.Migration.Status)
+}
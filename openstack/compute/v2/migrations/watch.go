@@ -0,0 +1,250 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// Action is an intervention Watch can make on a live migration.
+type Action string
+
+const (
+	// ActionNone lets the migration carry on.
+	ActionNone Action = ""
+
+	// ActionForceComplete forces the migration to complete, see
+	// ForceComplete.
+	ActionForceComplete Action = "force_complete"
+
+	// ActionAbort cancels the migration, see Abort.
+	ActionAbort Action = "abort"
+)
+
+// Progress is a snapshot of an in-progress live migration.
+type Progress struct {
+	// Migration is the migration as last returned by Get.
+	Migration ServerMigration
+
+	// Elapsed is the time since Watch started watching the migration. It
+	// does not depend on the clocks of the compute services.
+	Elapsed time.Duration
+
+	// Stalled is the number of consecutive polls during which the memory
+	// left to transfer did not decrease, e.g. because the server dirties
+	// its memory faster than it can be copied.
+	Stalled int
+
+	// Actions are the actions taken on the migration so far.
+	Actions []Action
+}
+
+// MemoryPercent returns the percentage of the memory of the server which
+// has been transferred, or 0 if it is not known yet.
+func (p Progress) MemoryPercent() float64 {
+	return percent(p.Migration.MemoryTotalBytes, p.Migration.MemoryRemainingBytes)
+}
+
+// DiskPercent returns the percentage of the local disks of the server which
+// have been transferred, or 0 if no disk is transferred.
+func (p Progress) DiskPercent() float64 {
+	return percent(p.Migration.DiskTotalBytes, p.Migration.DiskRemainingBytes)
+}
+
+func percent(total, remaining int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(total-remaining) * 100 / float64(total)
+}
+
+// Policy decides which action to take on a live migration after each poll.
+type Policy interface {
+	Decide(Progress) Action
+}
+
+// PolicyFunc is a function which implements Policy.
+type PolicyFunc func(Progress) Action
+
+// Decide calls f(p).
+func (f PolicyFunc) Decide(p Progress) Action {
+	return f(p)
+}
+
+// ThresholdPolicy is a Policy which intervenes once a migration runs for too
+// long or stops making progress. Zero fields are ignored. Abort thresholds
+// are checked before force-complete ones.
+type ThresholdPolicy struct {
+	// ForceCompleteAfter forces the migration to complete once it has run
+	// for this long.
+	ForceCompleteAfter time.Duration
+
+	// ForceCompleteAbove forces the migration to complete once this
+	// percentage of the memory has been transferred, so that the server is
+	// paused for a short time only.
+	ForceCompleteAbove float64
+
+	// AbortAfter aborts the migration once it has run for this long.
+	AbortAfter time.Duration
+
+	// AbortAfterStalled aborts the migration once it has not made progress
+	// for this number of consecutive polls.
+	AbortAfterStalled int
+}
+
+// Decide implements Policy.
+func (p ThresholdPolicy) Decide(progress Progress) Action {
+	switch {
+	case p.AbortAfter > 0 && progress.Elapsed >= p.AbortAfter:
+		return ActionAbort
+	case p.AbortAfterStalled > 0 && progress.Stalled >= p.AbortAfterStalled:
+		return ActionAbort
+	case p.ForceCompleteAfter > 0 && progress.Elapsed >= p.ForceCompleteAfter:
+		return ActionForceComplete
+	case p.ForceCompleteAbove > 0 && progress.MemoryPercent() >= p.ForceCompleteAbove:
+		return ActionForceComplete
+	}
+	return ActionNone
+}
+
+// WatchOpts represents the options of a Watch.
+type WatchOpts struct {
+	// Interval is the time between two polls. It defaults to 5 seconds.
+	Interval time.Duration
+
+	// Policy decides after each poll whether to intervene on the migration.
+	// Each action is taken at most once, and no action is taken after
+	// ActionAbort. If nil, the migration is only watched.
+	Policy Policy
+
+	// OnProgress is called after each poll.
+	OnProgress func(Progress)
+}
+
+// WatchResult is the outcome of a Watch.
+type WatchResult struct {
+	// Last is the migration as last returned by Get, or nil if it was over
+	// before the first poll.
+	Last *ServerMigration
+
+	// Actions are the actions taken on the migration.
+	Actions []Action
+
+	// Migration is the record of the finished migration, whose Status tells
+	// whether it completed. It is nil if the migration could not be found
+	// with List.
+	Migration *Migration
+}
+
+// Watch polls an in-progress live migration of a server until it is over,
+// reporting its progress to opts.OnProgress and applying opts.Policy. It
+// then looks up the final status of the migration with List.
+//
+// Watch requires microversion 2.23 or later, and 2.24 or later to abort
+// migrations.
+func Watch(ctx context.Context, client *gophercloud.ServiceClient, serverID string, migrationID int, opts WatchOpts) (*WatchResult, error) {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	res := new(WatchResult)
+	start := time.Now()
+	stalled := 0
+	for {
+		m, err := Get(ctx, client, serverID, migrationID).Extract()
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			break
+		}
+		if err != nil {
+			return res, err
+		}
+
+		if res.Last != nil && m.MemoryTotalBytes > 0 && m.MemoryRemainingBytes >= res.Last.MemoryRemainingBytes {
+			stalled++
+		} else {
+			stalled = 0
+		}
+		res.Last = m
+
+		progress := Progress{
+			Migration: *m,
+			Elapsed:   time.Since(start),
+			Stalled:   stalled,
+			Actions:   slices.Clone(res.Actions),
+		}
+		if opts.OnProgress != nil {
+			opts.OnProgress(progress)
+		}
+
+		if opts.Policy != nil {
+			if err := res.apply(ctx, client, serverID, migrationID, opts.Policy.Decide(progress)); err != nil {
+				return res, err
+			}
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return res, ctx.Err()
+		}
+	}
+
+	listOpts := ListOpts{
+		InstanceUUID:  serverID,
+		MigrationType: TypeLiveMigration,
+	}
+	pages, err := List(client, listOpts).AllPages(ctx)
+	if err != nil {
+		return res, err
+	}
+	all, err := ExtractMigrations(pages)
+	if err != nil {
+		return res, err
+	}
+	for i := range all {
+		if all[i].ID == migrationID {
+			res.Migration = &all[i]
+			break
+		}
+	}
+
+	return res, nil
+}
+
+// apply takes action on the migration, unless it was taken already or the
+// migration was aborted. The compute service refuses to act on a migration
+// which is not running yet with a 409 Conflict, in which case the action is
+// tried again after the next poll.
+func (res *WatchResult) apply(ctx context.Context, client *gophercloud.ServiceClient, serverID string, migrationID int, action Action) error {
+	if action == ActionNone {
+		return nil
+	}
+	for _, a := range res.Actions {
+		if a == action || a == ActionAbort {
+			return nil
+		}
+	}
+
+	var err error
+	switch action {
+	case ActionForceComplete:
+		err = ForceComplete(ctx, client, serverID, migrationID).ExtractErr()
+	case ActionAbort:
+		err = Abort(ctx, client, serverID, migrationID).ExtractErr()
+	default:
+		err = fmt.Errorf("unknown migration action %q", action)
+	}
+	if gophercloud.ResponseCodeIs(err, http.StatusConflict) && res.Last != nil && res.Last.Status != StatusRunning {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	res.Actions = append(res.Actions, action)
+	return nil
+}
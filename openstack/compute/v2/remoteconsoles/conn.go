@@ -0,0 +1,444 @@
+package remoteconsoles
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+const (
+	// SubprotocolBinary carries the console data as binary frames.
+	SubprotocolBinary = "binary"
+
+	// SubprotocolBase64 carries the console data base64 encoded in text
+	// frames. It is only used by proxies which do not support binary frames.
+	SubprotocolBase64 = "base64"
+)
+
+// websocketGUID is appended to the key of the handshake, see RFC 6455.
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// maxFrameSize bounds the payload of a single frame received from the proxy.
+const maxFrameSize = 16 << 20
+
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xa
+)
+
+// DialOpts configures Dial.
+type DialOpts struct {
+	// TLSConfig is used to connect to wss URLs.
+	TLSConfig *tls.Config
+
+	// Subprotocols are offered to the console proxy, in order of
+	// preference. Defaults to SubprotocolBinary and SubprotocolBase64.
+	Subprotocols []string
+
+	// Origin is sent to the console proxy, which checks it against its
+	// allowed origins. Defaults to the scheme and host of the console URL.
+	Origin string
+
+	// Header holds additional headers of the handshake.
+	Header http.Header
+
+	// NetDialer is used to open the connection. Defaults to a zero
+	// net.Dialer.
+	NetDialer *net.Dialer
+}
+
+// WebsocketURL returns the websocket URL of a console. Serial consoles are
+// returned as a websocket URL already. The URL of noVNC and SPICE consoles
+// points to the HTML client instead, and passes the token either directly
+// or within the path query parameter.
+func WebsocketURL(console *RemoteConsole) (*url.URL, error) {
+	u, err := url.Parse(console.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "ws", "wss":
+		return u, nil
+	case "http":
+		u.Scheme = "ws"
+	case "https":
+		u.Scheme = "wss"
+	default:
+		return nil, fmt.Errorf("unsupported console URL scheme %q", u.Scheme)
+	}
+
+	q := u.Query()
+	if path := q.Get("path"); path != "" {
+		ref, err := url.Parse(path)
+		if err != nil {
+			return nil, err
+		}
+		u.Path, u.RawQuery = "/", ""
+		return u.ResolveReference(ref), nil
+	}
+
+	token := q.Get("token")
+	if token == "" {
+		return nil, fmt.Errorf("console URL %s has no token", console.URL)
+	}
+	u.Path, u.RawQuery = "/", url.Values{"token": {token}}.Encode()
+	return u, nil
+}
+
+// Conn is an open console. Reading returns what the server writes to the
+// console, and writing sends input to it. For VNC consoles, the data is the
+// raw RFB protocol.
+//
+// A Conn may be read from and written to concurrently.
+type Conn struct {
+	// Subprotocol is the subprotocol the console proxy accepted.
+	Subprotocol string
+
+	conn net.Conn
+	br   *bufio.Reader
+
+	rmu     sync.Mutex
+	pending []byte
+	message []byte
+	readErr error
+
+	wmu       sync.Mutex
+	closeOnce sync.Once
+}
+
+// Dial connects to a console returned by Create.
+func Dial(ctx context.Context, console *RemoteConsole, opts DialOpts) (*Conn, error) {
+	u, err := WebsocketURL(console)
+	if err != nil {
+		return nil, err
+	}
+
+	netDialer := opts.NetDialer
+	if netDialer == nil {
+		netDialer = new(net.Dialer)
+	}
+
+	addr := u.Host
+	if u.Port() == "" {
+		if u.Scheme == "wss" {
+			addr = net.JoinHostPort(u.Hostname(), "443")
+		} else {
+			addr = net.JoinHostPort(u.Hostname(), "80")
+		}
+	}
+
+	var conn net.Conn
+	if u.Scheme == "wss" {
+		tlsConfig := opts.TLSConfig
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		}
+		tlsDialer := &tls.Dialer{NetDialer: netDialer, Config: tlsConfig}
+		conn, err = tlsDialer.DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = netDialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Interrupt the handshake if ctx is done.
+	stop := context.AfterFunc(ctx, func() {
+		conn.SetDeadline(time.Unix(1, 0))
+	})
+	c, err := handshake(conn, u, opts)
+	if !stop() {
+		err = ctx.Err()
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	conn.SetDeadline(time.Time{})
+
+	return c, nil
+}
+
+func handshake(conn net.Conn, u *url.URL, opts DialOpts) (*Conn, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(nonce)
+
+	subprotocols := opts.Subprotocols
+	if len(subprotocols) == 0 {
+		subprotocols = []string{SubprotocolBinary, SubprotocolBase64}
+	}
+
+	origin := opts.Origin
+	if origin == "" {
+		scheme := "http"
+		if u.Scheme == "wss" {
+			scheme = "https"
+		}
+		origin = scheme + "://" + u.Host
+	}
+
+	req := &http.Request{
+		Method:     http.MethodGet,
+		URL:        u,
+		Host:       u.Host,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     make(http.Header),
+	}
+	for k, v := range opts.Header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Sec-WebSocket-Key", key)
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Protocol", strings.Join(subprotocols, ", "))
+	req.Header.Set("Origin", origin)
+	if err := req.Write(conn); err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+		return nil, ErrHandshake{StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(body))}
+	}
+
+	if !strings.EqualFold(resp.Header.Get("Upgrade"), "websocket") {
+		return nil, fmt.Errorf("console proxy did not upgrade the connection to a websocket")
+	}
+	sum := sha1.Sum([]byte(key + websocketGUID))
+	if resp.Header.Get("Sec-WebSocket-Accept") != base64.StdEncoding.EncodeToString(sum[:]) {
+		return nil, fmt.Errorf("console proxy returned an invalid Sec-WebSocket-Accept")
+	}
+
+	c := &Conn{conn: conn, br: br, Subprotocol: resp.Header.Get("Sec-WebSocket-Protocol")}
+	switch c.Subprotocol {
+	case "":
+		c.Subprotocol = SubprotocolBinary
+	case SubprotocolBinary, SubprotocolBase64:
+	default:
+		return nil, fmt.Errorf("console proxy selected unsupported subprotocol %q", c.Subprotocol)
+	}
+	return c, nil
+}
+
+// Read reads console output.
+func (c *Conn) Read(p []byte) (int, error) {
+	c.rmu.Lock()
+	defer c.rmu.Unlock()
+
+	for len(c.pending) == 0 {
+		if c.readErr != nil {
+			return 0, c.readErr
+		}
+		if err := c.readMessage(); err != nil {
+			c.readErr = err
+		}
+	}
+
+	n := copy(p, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+// readMessage reads frames until a data message is complete, answering
+// control frames along the way.
+func (c *Conn) readMessage() error {
+	for {
+		fin, opcode, payload, err := c.readFrame()
+		if err != nil {
+			return err
+		}
+
+		switch opcode {
+		case opPing:
+			if err := c.writeFrame(opPong, payload); err != nil {
+				return err
+			}
+			continue
+		case opPong:
+			continue
+		case opClose:
+			c.writeFrame(opClose, payload)
+			return io.EOF
+		case opText, opBinary:
+			c.message = append(c.message[:0], payload...)
+		case opContinuation:
+			c.message = append(c.message, payload...)
+		default:
+			return fmt.Errorf("console proxy sent unknown opcode %d", opcode)
+		}
+
+		if !fin {
+			continue
+		}
+
+		if c.Subprotocol == SubprotocolBase64 {
+			decoded, err := base64.StdEncoding.DecodeString(string(c.message))
+			if err != nil {
+				return err
+			}
+			c.pending = decoded
+		} else {
+			c.pending = append([]byte(nil), c.message...)
+		}
+		return nil
+	}
+}
+
+func (c *Conn) readFrame() (fin bool, opcode byte, payload []byte, err error) {
+	var header [2]byte
+	if _, err = io.ReadFull(c.br, header[:]); err != nil {
+		return
+	}
+	fin = header[0]&0x80 != 0
+	opcode = header[0] & 0x0f
+	masked := header[1]&0x80 != 0
+
+	size := uint64(header[1] & 0x7f)
+	switch size {
+	case 126:
+		var ext [2]byte
+		if _, err = io.ReadFull(c.br, ext[:]); err != nil {
+			return
+		}
+		size = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err = io.ReadFull(c.br, ext[:]); err != nil {
+			return
+		}
+		size = binary.BigEndian.Uint64(ext[:])
+	}
+	if size > maxFrameSize {
+		err = fmt.Errorf("console proxy sent a frame of %d bytes", size)
+		return
+	}
+
+	var mask [4]byte
+	if masked {
+		if _, err = io.ReadFull(c.br, mask[:]); err != nil {
+			return
+		}
+	}
+
+	payload = make([]byte, size)
+	if _, err = io.ReadFull(c.br, payload); err != nil {
+		return
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	return
+}
+
+// Write sends input to the console.
+func (c *Conn) Write(p []byte) (int, error) {
+	opcode, payload := byte(opBinary), p
+	if c.Subprotocol == SubprotocolBase64 {
+		opcode, payload = opText, []byte(base64.StdEncoding.EncodeToString(p))
+	}
+	if err := c.writeFrame(opcode, payload); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (c *Conn) writeFrame(opcode byte, payload []byte) error {
+	frame := make([]byte, 0, len(payload)+14)
+	frame = append(frame, 0x80|opcode)
+	switch {
+	case len(payload) < 126:
+		frame = append(frame, 0x80|byte(len(payload)))
+	case len(payload) <= 0xffff:
+		frame = append(frame, 0x80|126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(len(payload)))
+	default:
+		frame = append(frame, 0x80|127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(len(payload)))
+	}
+
+	// Frames sent by clients must be masked.
+	var mask [4]byte
+	if _, err := rand.Read(mask[:]); err != nil {
+		return err
+	}
+	frame = append(frame, mask[:]...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	_, err := c.conn.Write(frame)
+	return err
+}
+
+// SetDeadline sets the read and write deadlines of the connection, e.g. to
+// stop waiting for a prompt which does not show up.
+func (c *Conn) SetDeadline(t time.Time) error {
+	return c.conn.SetDeadline(t)
+}
+
+// Close closes the console.
+func (c *Conn) Close() error {
+	err := net.ErrClosed
+	c.closeOnce.Do(func() {
+		// 1000 is the status code of a normal closure.
+		c.writeFrame(opClose, []byte{0x03, 0xe8})
+		err = c.conn.Close()
+	})
+	return err
+}
+
+// Attach creates a console on a server and connects to it.
+func Attach(ctx context.Context, client *gophercloud.ServiceClient, serverID string, createOpts CreateOptsBuilder, dialOpts DialOpts) (*Conn, error) {
+	console, err := Create(ctx, client, serverID, createOpts).Extract()
+	if err != nil {
+		return nil, err
+	}
+	return Dial(ctx, console, dialOpts)
+}
+
+// ErrHandshake is returned by Dial when the console proxy refuses the
+// connection, e.g. because the token expired.
+type ErrHandshake struct {
+	gophercloud.BaseError
+	StatusCode int
+	Body       string
+}
+
+func (e ErrHandshake) Error() string {
+	return fmt.Sprintf("Console proxy refused the connection with status %d: %s", e.StatusCode, e.Body)
+}
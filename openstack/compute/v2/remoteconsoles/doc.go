@@ -1,6 +1,7 @@
 /*
 Package remoteconsoles provides the ability to create server remote consoles
-through the Compute API.
+through the Compute API, to connect to them, and to follow the console output
+of servers.
 You need to specify at least "2.6" microversion for the ComputeClient to use
 that API.
 
@@ -21,5 +22,54 @@ Example of Creating a new RemoteConsole
 	}
 
 	fmt.Printf("Console URL: %s\n", remtoteConsole.URL)
+
+Example of Attaching to a Serial Console
+
+	computeClient.Microversion = "2.6"
+
+	createOpts := remoteconsoles.CreateOpts{
+	  Protocol: remoteconsoles.ConsoleProtocolSerial,
+	  Type:     remoteconsoles.ConsoleTypeSerial,
+	}
+
+	conn, err := remoteconsoles.Attach(context.TODO(), computeClient, serverID, createOpts, remoteconsoles.DialOpts{})
+	if err != nil {
+	  panic(err)
+	}
+	defer conn.Close()
+
+	go io.Copy(conn, os.Stdin)
+	io.Copy(os.Stdout, conn)
+
+Example of Connecting to an Existing noVNC Console
+
+	conn, err := remoteconsoles.Dial(context.TODO(), remoteConsole, remoteconsoles.DialOpts{})
+	if err != nil {
+	  panic(err)
+	}
+	defer conn.Close()
+
+	// conn carries the raw RFB protocol.
+	version := make([]byte, 12)
+	if _, err := io.ReadFull(conn, version); err != nil {
+	  panic(err)
+	}
+
+Example of Following the Console Output of a Server
+
+	tailOpts := remoteconsoles.TailOpts{
+	  Interval: 5 * time.Second,
+	  Length:   100,
+	}
+
+	tail := remoteconsoles.TailOutput(ctx, computeClient, serverID, tailOpts)
+	defer tail.Close()
+
+	scanner := bufio.NewScanner(tail)
+	for scanner.Scan() {
+	  if strings.Contains(scanner.Text(), "Cloud-init") {
+	    fmt.Println(scanner.Text())
+	  }
+	}
 */
 package remoteconsoles
@@ -0,0 +1,127 @@
+package remoteconsoles
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/servers"
+)
+
+// errTailClosed is the cause of the cancellation of a closed OutputTail.
+var errTailClosed = errors.New("console output tail closed")
+
+// DefaultTailInterval is the time between two polls of the console output
+// when TailOpts.Interval is not set.
+const DefaultTailInterval = 2 * time.Second
+
+// TailOpts configures TailOutput.
+type TailOpts struct {
+	// Interval is the time between two polls of the console output.
+	// Defaults to DefaultTailInterval.
+	Interval time.Duration
+
+	// Length is the number of lines fetched by each poll. The output
+	// written between two polls is lost if it exceeds this number of lines.
+	// All lines are fetched if not set.
+	Length int
+}
+
+// OutputTail reads the console output of a server as it grows, by polling
+// servers.ShowConsoleOutput. It can be used on clouds which do not provide
+// interactive consoles.
+type OutputTail struct {
+	client   *gophercloud.ServiceClient
+	serverID string
+	opts     TailOpts
+
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+
+	polled  bool
+	last    string
+	pending []byte
+}
+
+// TailOutput returns an io.ReadCloser of the console output of a server.
+// The first Read returns the output logged so far, and later ones block
+// until more is logged. Reads fail once ctx is done.
+func TailOutput(ctx context.Context, client *gophercloud.ServiceClient, serverID string, opts TailOpts) *OutputTail {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultTailInterval
+	}
+	t := &OutputTail{
+		client:   client,
+		serverID: serverID,
+		opts:     opts,
+	}
+	t.ctx, t.cancel = context.WithCancelCause(ctx)
+	return t
+}
+
+// Read reads console output. It returns io.EOF once the tail is closed.
+func (t *OutputTail) Read(p []byte) (int, error) {
+	for len(t.pending) == 0 {
+		if t.polled {
+			select {
+			case <-time.After(t.opts.Interval):
+			case <-t.ctx.Done():
+				return 0, t.err()
+			}
+		}
+
+		output, err := servers.ShowConsoleOutput(t.ctx, t.client, t.serverID, servers.ShowConsoleOutputOpts{Length: t.opts.Length}).Extract()
+		if err != nil {
+			if t.ctx.Err() != nil {
+				return 0, t.err()
+			}
+			return 0, err
+		}
+		t.polled = true
+		t.pending = []byte(newOutput(t.last, output))
+		t.last = output
+	}
+
+	n := copy(p, t.pending)
+	t.pending = t.pending[n:]
+	return n, nil
+}
+
+func (t *OutputTail) err() error {
+	if context.Cause(t.ctx) == errTailClosed {
+		return io.EOF
+	}
+	return t.ctx.Err()
+}
+
+// Close stops the tail.
+func (t *OutputTail) Close() error {
+	t.cancel(errTailClosed)
+	return nil
+}
+
+// newOutput returns what was logged between two polls of the console
+// output. As the output returned by a poll may start later than the one
+// returned by the previous poll, the longest end of the previous output,
+// starting at a line, which the current output starts with is looked up.
+// If there is none, e.g. because the console log was rotated, the whole
+// current output is new.
+func newOutput(last, current string) string {
+	if last == "" {
+		return current
+	}
+	for start := 0; start < len(last); {
+		if strings.HasPrefix(current, last[start:]) {
+			return current[len(last)-start:]
+		}
+		i := strings.IndexByte(last[start:], '\n')
+		if i < 0 {
+			break
+		}
+		start += i + 1
+	}
+	return current
+}
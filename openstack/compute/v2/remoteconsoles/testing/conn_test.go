@@ -0,0 +1,150 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/remoteconsoles"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestWebsocketURL(t *testing.T) {
+	for consoleURL, expected := range map[string]string{
+		"ws://192.168.0.4:6083/?token=" + ConsoleToken:                                  "ws://192.168.0.4:6083/?token=" + ConsoleToken,
+		"http://192.168.0.4:6080/vnc_auto.html?token=" + ConsoleToken:                   "ws://192.168.0.4:6080/?token=" + ConsoleToken,
+		"https://console.example.com/vnc_lite.html?path=%3Ftoken%3D" + ConsoleToken:     "wss://console.example.com/?token=" + ConsoleToken,
+		"https://console.example.com/vnc/vnc_lite.html?path=vnc%2F%3Ftoken%3D" + "abc":  "wss://console.example.com/vnc/?token=abc",
+		"http://192.168.0.4:6082/spice_auto.html?token=" + ConsoleToken + "&title=test": "ws://192.168.0.4:6082/?token=" + ConsoleToken,
+	} {
+		u, err := remoteconsoles.WebsocketURL(&remoteconsoles.RemoteConsole{URL: consoleURL})
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, expected, u.String())
+	}
+
+	_, err := remoteconsoles.WebsocketURL(&remoteconsoles.RemoteConsole{URL: "http://192.168.0.4:6080/vnc_auto.html"})
+	th.AssertErr(t, err)
+}
+
+func TestDial(t *testing.T) {
+	done := make(chan struct{})
+	server, consoleURL := NewConsoleProxy(t, func(c *proxyConn) {
+		defer close(done)
+
+		// A fragmented message, with a ping in between.
+		c.WriteFrame(false, 0x2, []byte("Ubuntu 24.04 "))
+		c.WriteFrame(true, 0x9, []byte("ping"))
+		c.WriteFrame(true, 0x0, []byte("web-01 ttyS0\n\nlogin: "))
+
+		opcode, payload := c.ReadFrame()
+		th.AssertEquals(t, byte(0xa), opcode)
+		th.AssertEquals(t, "ping", string(payload))
+
+		th.AssertEquals(t, "root\n", c.ReadData())
+		c.WriteData("Password: ")
+
+		// A close, which the client echoes.
+		c.WriteFrame(true, 0x8, []byte{0x03, 0xe8})
+		opcode, _ = c.ReadFrame()
+		th.AssertEquals(t, byte(0x8), opcode)
+	})
+	defer server.Close()
+
+	console := &remoteconsoles.RemoteConsole{
+		Protocol: "serial",
+		Type:     "serial",
+		URL:      consoleURL,
+	}
+	conn, err := remoteconsoles.Dial(context.TODO(), console, remoteconsoles.DialOpts{})
+	th.AssertNoErr(t, err)
+	defer conn.Close()
+	th.AssertEquals(t, remoteconsoles.SubprotocolBinary, conn.Subprotocol)
+
+	banner := make([]byte, len("Ubuntu 24.04 web-01 ttyS0\n\nlogin: "))
+	_, err = io.ReadFull(conn, banner)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "Ubuntu 24.04 web-01 ttyS0\n\nlogin: ", string(banner))
+
+	_, err = fmt.Fprint(conn, "root\n")
+	th.AssertNoErr(t, err)
+
+	rest, err := io.ReadAll(conn)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "Password: ", string(rest))
+	<-done
+}
+
+func TestDialBase64(t *testing.T) {
+	server, consoleURL := NewConsoleProxy(t, func(c *proxyConn) {
+		c.WriteData(strings.Repeat("=", 200))
+		th.AssertEquals(t, "echo\n", c.ReadData())
+	})
+	defer server.Close()
+
+	dialOpts := remoteconsoles.DialOpts{
+		Subprotocols: []string{remoteconsoles.SubprotocolBase64},
+	}
+	conn, err := remoteconsoles.Dial(context.TODO(), &remoteconsoles.RemoteConsole{URL: consoleURL}, dialOpts)
+	th.AssertNoErr(t, err)
+	defer conn.Close()
+	th.AssertEquals(t, remoteconsoles.SubprotocolBase64, conn.Subprotocol)
+
+	_, err = fmt.Fprint(conn, "echo\n")
+	th.AssertNoErr(t, err)
+
+	b, err := io.ReadAll(conn)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, strings.Repeat("=", 200), string(b))
+}
+
+func TestDialInvalidToken(t *testing.T) {
+	server, consoleURL := NewConsoleProxy(t, func(c *proxyConn) {
+		t.Error("connection should have been refused")
+	})
+	defer server.Close()
+
+	consoleURL = strings.Replace(consoleURL, ConsoleToken, "expired", 1)
+	_, err := remoteconsoles.Dial(context.TODO(), &remoteconsoles.RemoteConsole{URL: consoleURL}, remoteconsoles.DialOpts{})
+
+	var errHandshake remoteconsoles.ErrHandshake
+	th.AssertEquals(t, true, errors.As(err, &errHandshake))
+	th.AssertEquals(t, http.StatusForbidden, errHandshake.StatusCode)
+	th.AssertEquals(t, "invalid token", errHandshake.Body)
+}
+
+func TestAttach(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	server, consoleURL := NewConsoleProxy(t, func(c *proxyConn) {
+		c.WriteData("login: ")
+	})
+	defer server.Close()
+
+	th.Mux.HandleFunc("/servers/b16ba811-199d-4ffd-8839-ba96c1185a67/remote-consoles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"remote_console": {"protocol": "serial", "type": "serial"}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"remote_console": {"protocol": "serial", "type": "serial", "url": %q}}`, consoleURL)
+	})
+
+	createOpts := remoteconsoles.CreateOpts{
+		Protocol: remoteconsoles.ConsoleProtocolSerial,
+		Type:     remoteconsoles.ConsoleTypeSerial,
+	}
+	conn, err := remoteconsoles.Attach(context.TODO(), fake.ServiceClient(), "b16ba811-199d-4ffd-8839-ba96c1185a67", createOpts, remoteconsoles.DialOpts{})
+	th.AssertNoErr(t, err)
+	defer conn.Close()
+
+	b, err := io.ReadAll(conn)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "login: ", string(b))
+}
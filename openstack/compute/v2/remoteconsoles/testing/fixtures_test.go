@@ -1,5 +1,22 @@
 package testing
 
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
 // RemoteConsoleCreateRequest represents a request to create a remote console.
 const RemoteConsoleCreateRequest = `
 {
@@ -20,3 +37,138 @@ const RemoteConsoleCreateResult = `
     }
 }
 `
+
+// ConsoleToken is the token of the consoles served by the fake console
+// proxy.
+const ConsoleToken = "9a2372b9-6a0e-4f71-aca1-56020e6bb677"
+
+// proxyConn is the server side of a websocket connection to the fake console
+// proxy.
+type proxyConn struct {
+	t           *testing.T
+	conn        net.Conn
+	br          *bufio.Reader
+	subprotocol string
+}
+
+// NewConsoleProxy starts a fake console proxy, which accepts websocket
+// connections carrying ConsoleToken and hands them to serve. It returns
+// the URL of a serial console served by the proxy.
+func NewConsoleProxy(t *testing.T, serve func(*proxyConn)) (*httptest.Server, string) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Upgrade", "websocket")
+		th.TestHeader(t, r, "Sec-WebSocket-Version", "13")
+		th.TestHeader(t, r, "Origin", "http://"+r.Host)
+
+		if r.URL.Query().Get("token") != ConsoleToken {
+			http.Error(w, "invalid token", http.StatusForbidden)
+			return
+		}
+
+		subprotocol := strings.Split(r.Header.Get("Sec-WebSocket-Protocol"), ", ")[0]
+		sum := sha1.Sum([]byte(r.Header.Get("Sec-WebSocket-Key") + "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"))
+
+		conn, brw, err := http.NewResponseController(w).Hijack()
+		th.AssertNoErr(t, err)
+		defer conn.Close()
+
+		fmt.Fprintf(brw, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n")
+		fmt.Fprintf(brw, "Sec-WebSocket-Accept: %s\r\nSec-WebSocket-Protocol: %s\r\n\r\n", base64.StdEncoding.EncodeToString(sum[:]), subprotocol)
+		th.AssertNoErr(t, brw.Flush())
+
+		serve(&proxyConn{t: t, conn: conn, br: brw.Reader, subprotocol: subprotocol})
+	}))
+	return server, strings.Replace(server.URL, "http://", "ws://", 1) + "/?token=" + ConsoleToken
+}
+
+// ReadFrame reads a frame sent by the client, which must be masked.
+func (c *proxyConn) ReadFrame() (byte, []byte) {
+	var header [2]byte
+	_, err := io.ReadFull(c.br, header[:])
+	th.AssertNoErr(c.t, err)
+	th.AssertEquals(c.t, true, header[1]&0x80 != 0)
+
+	size := int(header[1] & 0x7f)
+	if size == 126 {
+		var ext [2]byte
+		_, err = io.ReadFull(c.br, ext[:])
+		th.AssertNoErr(c.t, err)
+		size = int(binary.BigEndian.Uint16(ext[:]))
+	}
+
+	var mask [4]byte
+	_, err = io.ReadFull(c.br, mask[:])
+	th.AssertNoErr(c.t, err)
+	payload := make([]byte, size)
+	_, err = io.ReadFull(c.br, payload)
+	th.AssertNoErr(c.t, err)
+	for i := range payload {
+		payload[i] ^= mask[i%4]
+	}
+	return header[0] & 0x0f, payload
+}
+
+// ReadData reads a data frame, decoding it if the base64 subprotocol is
+// used.
+func (c *proxyConn) ReadData() string {
+	opcode, payload := c.ReadFrame()
+	if c.subprotocol == "base64" {
+		th.AssertEquals(c.t, byte(0x1), opcode)
+		decoded, err := base64.StdEncoding.DecodeString(string(payload))
+		th.AssertNoErr(c.t, err)
+		return string(decoded)
+	}
+	th.AssertEquals(c.t, byte(0x2), opcode)
+	return string(payload)
+}
+
+// WriteFrame writes an unmasked frame.
+func (c *proxyConn) WriteFrame(fin bool, opcode byte, payload []byte) {
+	header := []byte{opcode, byte(len(payload))}
+	if fin {
+		header[0] |= 0x80
+	}
+	if len(payload) >= 126 {
+		header[1] = 126
+		header = binary.BigEndian.AppendUint16(header, uint16(len(payload)))
+	}
+	_, err := c.conn.Write(append(header, payload...))
+	th.AssertNoErr(c.t, err)
+}
+
+// WriteData writes a data frame, encoding it if the base64 subprotocol is
+// used.
+func (c *proxyConn) WriteData(data string) {
+	if c.subprotocol == "base64" {
+		c.WriteFrame(true, 0x1, []byte(base64.StdEncoding.EncodeToString([]byte(data))))
+		return
+	}
+	c.WriteFrame(true, 0x2, []byte(data))
+}
+
+// ConsoleOutputs are the successive outputs returned by HandleShowConsoleOutput.
+var ConsoleOutputs = []string{
+	"line1\nline2\n",
+	"line2\nline3\nli",
+	"line3\nline4\n",
+	"rebooted\n",
+}
+
+// HandleShowConsoleOutput returns ConsoleOutputs one after the other, and
+// then the last one forever.
+func HandleShowConsoleOutput(t *testing.T) {
+	var polls int
+	th.Mux.HandleFunc("/servers/b16ba811-199d-4ffd-8839-ba96c1185a67/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"os-getConsoleOutput": {"length": 3}}`)
+
+		output := ConsoleOutputs[min(polls, len(ConsoleOutputs)-1)]
+		polls++
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"output": %q}`, output)
+	})
+}
@@ -0,0 +1,53 @@
+package testing
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/remoteconsoles"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestTailOutput(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleShowConsoleOutput(t)
+
+	tailOpts := remoteconsoles.TailOpts{
+		Interval: time.Millisecond,
+		Length:   3,
+	}
+	tail := remoteconsoles.TailOutput(context.TODO(), fake.ServiceClient(), "b16ba811-199d-4ffd-8839-ba96c1185a67", tailOpts)
+
+	expected := "line1\nline2\nline3\nline4\nrebooted\n"
+	b := make([]byte, len(expected))
+	_, err := io.ReadFull(tail, b)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, expected, string(b))
+
+	th.AssertNoErr(t, tail.Close())
+	_, err = tail.Read(b)
+	th.AssertEquals(t, io.EOF, err)
+}
+
+func TestTailOutputContextDone(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleShowConsoleOutput(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+
+	tailOpts := remoteconsoles.TailOpts{
+		Interval: time.Millisecond,
+		Length:   3,
+	}
+	tail := remoteconsoles.TailOutput(ctx, fake.ServiceClient(), "b16ba811-199d-4ffd-8839-ba96c1185a67", tailOpts)
+	defer tail.Close()
+
+	_, err := io.ReadAll(tail)
+	th.AssertEquals(t, context.DeadlineExceeded, err)
+}
@@ -58,6 +58,22 @@ Example to Create a Server
 		panic(err)
 	}
 
+Example to Create a Server on an Auto-Allocated Network
+
+	computeClient.Microversion = "2.37"
+
+	createOpts := servers.CreateOpts{
+		Name:      "server_name",
+		ImageRef:  "image-uuid",
+		FlavorRef: "flavor-uuid",
+		Networks:  "auto",
+	}
+
+	server, err := servers.Create(context.TODO(), computeClient, createOpts, nil).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Add a Server to a Server Group
 
 	schedulerHintOpts := servers.SchedulerHintOpts{
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
@@ -1370,3 +1371,15 @@ func Resume(ctx context.Context, client *gophercloud.ServiceClient, id string) (
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// IDFromName is a convenience function that returns a server's ID given its
+// name. Nova treats the name filter as a regular expression, so the name is
+// quoted and anchored before it is sent, and then matched exactly client-side.
+//
+// It returns a gophercloud.ErrResourceNotFound if no server has that name and a
+// gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	return utils.ResolveID(ctx, List(client, ListOpts{Name: "^" + regexp.QuoteMeta(name) + "$"}), ExtractServers, func(s Server) (string, string) {
+		return s.Name, s.ID
+	}, "server", name)
+}
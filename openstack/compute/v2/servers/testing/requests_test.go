@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/servers"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
@@ -107,6 +109,41 @@ func TestCreateServerNoNetwork(t *testing.T) {
 	th.CheckDeepEquals(t, ServerDerp, *actual)
 }
 
+func TestCreateServerAutoNetwork(t *testing.T) {
+	opts := servers.CreateOpts{
+		Name:      "createdserver",
+		ImageRef:  "asdfasdfasdf",
+		FlavorRef: "performance1-1",
+		Networks:  "auto",
+	}
+	expected := `
+		{
+			"server": {
+				"name": "createdserver",
+				"imageRef": "asdfasdfasdf",
+				"flavorRef": "performance1-1",
+				"networks": "auto"
+			}
+		}
+	`
+
+	actual, err := opts.ToServerCreateMap()
+	th.AssertNoErr(t, err)
+	th.CheckJSONEquals(t, expected, actual)
+}
+
+func TestCreateServerInvalidNetworks(t *testing.T) {
+	opts := servers.CreateOpts{
+		Name:      "createdserver",
+		ImageRef:  "asdfasdfasdf",
+		FlavorRef: "performance1-1",
+		Networks:  "all",
+	}
+
+	_, err := opts.ToServerCreateMap()
+	th.AssertErr(t, err)
+}
+
 func TestCreateServers(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -1182,3 +1219,53 @@ func TestCreateServerWithHypervisorHostname(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckJSONEquals(t, expected, actual)
 }
+
+func handleServerIDFromName(t *testing.T, name string, body string) {
+	th.Mux.HandleFunc("/servers/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"name": name})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, body)
+	})
+}
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleServerIDFromName(t, `^web\(1\)$`, `{"servers": [{"id": "5d5d7ee8-3ff9-4c8b-bd2c-0fbbab42e4c2", "name": "web(1)"}]}`)
+
+	id, err := servers.IDFromName(context.TODO(), client.ServiceClient(), "web(1)")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "5d5d7ee8-3ff9-4c8b-bd2c-0fbbab42e4c2", id)
+}
+
+func TestIDFromNameNotFound(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleServerIDFromName(t, `^db\.1\+$`, `{"servers": []}`)
+
+	_, err := servers.IDFromName(context.TODO(), client.ServiceClient(), "db.1+")
+	if _, ok := err.(gophercloud.ErrResourceNotFound); !ok {
+		t.Fatalf("Expected ErrResourceNotFound, got %v", err)
+	}
+}
+
+func TestIDFromNameMultipleFound(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleServerIDFromName(t, `^web$`, `
+		{
+			"servers": [
+				{"id": "5d5d7ee8-3ff9-4c8b-bd2c-0fbbab42e4c2", "name": "web"},
+				{"id": "9e5476bd-a4ec-4653-93d6-72c93aa682ba", "name": "web"}
+			]
+		}
+	`)
+
+	_, err := servers.IDFromName(context.TODO(), client.ServiceClient(), "web")
+	if _, ok := err.(gophercloud.ErrMultipleResourcesFound); !ok {
+		t.Fatalf("Expected ErrMultipleResourcesFound, got %v", err)
+	}
+}
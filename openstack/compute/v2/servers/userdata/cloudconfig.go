@@ -0,0 +1,84 @@
+package userdata
+
+import (
+	"gopkg.in/yaml.v2"
+)
+
+// CloudConfig is a cloud-config document, which cloud-init applies when the
+// server boots. Only the most common modules have a field; others can be
+// set through Extra.
+type CloudConfig struct {
+	Hostname       string `yaml:"hostname,omitempty"`
+	FQDN           string `yaml:"fqdn,omitempty"`
+	ManageEtcHosts bool   `yaml:"manage_etc_hosts,omitempty"`
+	Timezone       string `yaml:"timezone,omitempty"`
+	Locale         string `yaml:"locale,omitempty"`
+
+	// Users are the users to create. An entry named "default" keeps the
+	// default user of the image.
+	Users []User `yaml:"users,omitempty"`
+
+	// SSHAuthorizedKeys are added to the default user.
+	SSHAuthorizedKeys []string `yaml:"ssh_authorized_keys,omitempty"`
+
+	PackageUpdate  bool     `yaml:"package_update,omitempty"`
+	PackageUpgrade bool     `yaml:"package_upgrade,omitempty"`
+	Packages       []string `yaml:"packages,omitempty"`
+
+	WriteFiles []WriteFile `yaml:"write_files,omitempty"`
+
+	// BootCmd are shell commands run early on every boot.
+	BootCmd []string `yaml:"bootcmd,omitempty"`
+
+	// RunCmd are shell commands run once, late in the first boot.
+	RunCmd []string `yaml:"runcmd,omitempty"`
+
+	FinalMessage string `yaml:"final_message,omitempty"`
+
+	// Extra holds any other top-level key of the document. Its keys must
+	// not clash with the fields above.
+	Extra map[string]any `yaml:",inline"`
+}
+
+// User is an entry of the users of a cloud-config document.
+type User struct {
+	Name              string   `yaml:"name"`
+	Gecos             string   `yaml:"gecos,omitempty"`
+	PrimaryGroup      string   `yaml:"primary_group,omitempty"`
+	Groups            string   `yaml:"groups,omitempty"`
+	Shell             string   `yaml:"shell,omitempty"`
+	Sudo              string   `yaml:"sudo,omitempty"`
+	LockPasswd        *bool    `yaml:"lock_passwd,omitempty"`
+	HashedPasswd      string   `yaml:"hashed_passwd,omitempty"`
+	SSHAuthorizedKeys []string `yaml:"ssh_authorized_keys,omitempty"`
+	System            bool     `yaml:"system,omitempty"`
+}
+
+// WriteFile is an entry of the write_files of a cloud-config document.
+type WriteFile struct {
+	Path    string `yaml:"path"`
+	Content string `yaml:"content,omitempty"`
+
+	// Encoding of Content, e.g. "b64" or "gz+b64". Defaults to plain text.
+	Encoding string `yaml:"encoding,omitempty"`
+
+	Owner       string `yaml:"owner,omitempty"`
+	Permissions string `yaml:"permissions,omitempty"`
+	Append      bool   `yaml:"append,omitempty"`
+
+	// Defer writes the file after users and packages are set up.
+	Defer bool `yaml:"defer,omitempty"`
+}
+
+// ToPart renders the document as a cloud-config part.
+func (c CloudConfig) ToPart() (Part, error) {
+	b, err := yaml.Marshal(c)
+	if err != nil {
+		return Part{}, err
+	}
+	return Part{
+		ContentType: ContentTypeCloudConfig,
+		Filename:    "cloud-config.yaml",
+		Content:     append([]byte("#cloud-config\n"), b...),
+	}, nil
+}
@@ -0,0 +1,60 @@
+/*
+Package userdata builds cloud-init user data for servers, made of a
+cloud-config document, shell scripts, include URLs and part-handlers combined
+into a MIME multi-part document. The user data is gzipped when it would
+otherwise exceed the size limit of Nova.
+
+Example to Build User Data
+
+	userDataOpts := userdata.Opts{
+		CloudConfig: &userdata.CloudConfig{
+			Hostname: "web-01",
+			Packages: []string{"nginx"},
+			WriteFiles: []userdata.WriteFile{
+				{
+					Path:        "/etc/nginx/conf.d/app.conf",
+					Content:     appConf,
+					Permissions: "0644",
+				},
+			},
+		},
+		Scripts: []userdata.Script{
+			{
+				Filename: "enable-nginx.sh",
+				Content:  "#!/bin/sh\nsystemctl enable --now nginx\n",
+			},
+		},
+	}
+
+	userData, err := userDataOpts.ToUserData()
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := servers.CreateOpts{
+		Name:      "web-01",
+		ImageRef:  "image-uuid",
+		FlavorRef: "flavor-uuid",
+		UserData:  userData,
+	}
+
+Example to Create a Server with User Data
+
+	createOpts := userdata.CreateOptsExt{
+		CreateOptsBuilder: servers.CreateOpts{
+			Name:      "web-01",
+			ImageRef:  "image-uuid",
+			FlavorRef: "flavor-uuid",
+		},
+		UserData: userdata.Opts{
+			IncludeURLs: []string{"https://config.example.com/web.yaml"},
+			Compression: userdata.CompressAlways,
+		},
+	}
+
+	server, err := servers.Create(context.TODO(), computeClient, createOpts, nil).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package userdata
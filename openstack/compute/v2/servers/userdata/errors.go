@@ -0,0 +1,19 @@
+package userdata
+
+import (
+	"fmt"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// ErrTooLarge is returned when the user data exceeds the size limit, even
+// once compressed if compression was allowed.
+type ErrTooLarge struct {
+	gophercloud.BaseError
+	Size    int
+	MaxSize int
+}
+
+func (e ErrTooLarge) Error() string {
+	return fmt.Sprintf("User data is %d bytes once base64 encoded, more than the limit of %d bytes", e.Size, e.MaxSize)
+}
@@ -0,0 +1,240 @@
+package userdata
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/servers"
+)
+
+// Content types of the parts cloud-init handles.
+const (
+	ContentTypeCloudConfig   = "text/cloud-config"
+	ContentTypeShellScript   = "text/x-shellscript"
+	ContentTypeIncludeURL    = "text/x-include-url"
+	ContentTypePartHandler   = "text/part-handler"
+	ContentTypeCloudBoothook = "text/cloud-boothook"
+)
+
+// MaxSize is the largest user data Nova accepts, in bytes once base64
+// encoded.
+const MaxSize = 65535
+
+// Compression controls whether the user data is gzipped.
+type Compression int
+
+const (
+	// CompressAuto gzips the user data only if it would exceed the size
+	// limit otherwise.
+	CompressAuto Compression = iota
+
+	// CompressAlways always gzips the user data.
+	CompressAlways
+
+	// CompressNever never gzips the user data.
+	CompressNever
+)
+
+// Part is a single part of a multi-part user data document.
+type Part struct {
+	// ContentType tells cloud-init how to handle the part, e.g.
+	// ContentTypeShellScript.
+	ContentType string
+
+	// Filename is reported to cloud-init, which uses it e.g. to name the
+	// scripts it runs. Defaults to "part-NNN".
+	Filename string
+
+	Content []byte
+}
+
+// Script is a shell script or part-handler of the user data.
+type Script struct {
+	// Filename defaults to "part-NNN".
+	Filename string
+
+	Content string
+}
+
+// Builder allows extensions to produce custom user data.
+type Builder interface {
+	ToUserData() ([]byte, error)
+}
+
+// Opts describes user data made of several parts, which cloud-init receives
+// as a MIME multi-part document.
+type Opts struct {
+	// PartHandlers are Python part-handlers, which cloud-init loads before
+	// processing the other parts.
+	PartHandlers []Script
+
+	CloudConfig *CloudConfig
+
+	// IncludeURLs are URLs cloud-init downloads further user data from.
+	IncludeURLs []string
+
+	// Scripts are shell scripts run once, late in the first boot, in the
+	// order they are given.
+	Scripts []Script
+
+	// Parts are added after all other parts, as is.
+	Parts []Part
+
+	Compression Compression
+
+	// MaxSize is the size limit of the user data, in bytes once base64
+	// encoded. Defaults to MaxSize.
+	MaxSize int
+
+	// Boundary separates the parts of the document. Defaults to a random
+	// boundary.
+	Boundary string
+}
+
+// ToUserData renders the user data, gzipped if requested or needed, and
+// checks its size. The result can be set as servers.CreateOpts.UserData.
+func (opts Opts) ToUserData() ([]byte, error) {
+	var parts []Part
+	for _, h := range opts.PartHandlers {
+		parts = append(parts, Part{ContentType: ContentTypePartHandler, Filename: h.Filename, Content: []byte(h.Content)})
+	}
+	if opts.CloudConfig != nil {
+		p, err := opts.CloudConfig.ToPart()
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, p)
+	}
+	if len(opts.IncludeURLs) > 0 {
+		content := "#include\n" + strings.Join(opts.IncludeURLs, "\n") + "\n"
+		parts = append(parts, Part{ContentType: ContentTypeIncludeURL, Content: []byte(content)})
+	}
+	for _, s := range opts.Scripts {
+		parts = append(parts, Part{ContentType: ContentTypeShellScript, Filename: s.Filename, Content: []byte(s.Content)})
+	}
+	parts = append(parts, opts.Parts...)
+
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("user data has no part")
+	}
+
+	b, err := multipartDocument(parts, opts.Boundary)
+	if err != nil {
+		return nil, err
+	}
+
+	maxSize := opts.MaxSize
+	if maxSize <= 0 {
+		maxSize = MaxSize
+	}
+
+	if opts.Compression == CompressAlways || (opts.Compression == CompressAuto && EncodedLen(b) > maxSize) {
+		if b, err = compress(b); err != nil {
+			return nil, err
+		}
+	}
+
+	if size := EncodedLen(b); size > maxSize {
+		return nil, ErrTooLarge{Size: size, MaxSize: maxSize}
+	}
+
+	return b, nil
+}
+
+// EncodedLen returns the size of user data once base64 encoded, which is
+// what Nova checks against MaxSize.
+func EncodedLen(userData []byte) int {
+	return base64.StdEncoding.EncodedLen(len(userData))
+}
+
+func multipartDocument(parts []Part, boundary string) ([]byte, error) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	if boundary != "" {
+		if err := w.SetBoundary(boundary); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, p := range parts {
+		if p.ContentType == "" {
+			return nil, fmt.Errorf("part %d of the user data has no content type", i)
+		}
+		filename := p.Filename
+		if filename == "" {
+			filename = fmt.Sprintf("part-%03d", i+1)
+		}
+
+		h := make(textproto.MIMEHeader)
+		h.Set("Content-Type", fmt.Sprintf("%s; charset=%q", p.ContentType, "utf-8"))
+		h.Set("MIME-Version", "1.0")
+		h.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		pw, err := w.CreatePart(h)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := pw.Write(p.Content); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	var doc bytes.Buffer
+	fmt.Fprintf(&doc, "Content-Type: multipart/mixed; boundary=%q\r\n", w.Boundary())
+	doc.WriteString("MIME-Version: 1.0\r\n\r\n")
+	doc.Write(body.Bytes())
+	return doc.Bytes(), nil
+}
+
+func compress(b []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := zw.Write(b); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// CreateOptsExt adds user data built by a Builder to the base CreateOpts,
+// replacing any CreateOpts.UserData.
+type CreateOptsExt struct {
+	servers.CreateOptsBuilder
+
+	UserData Builder
+}
+
+// ToServerCreateMap adds the base64 encoded user_data to the base server
+// creation options.
+func (opts CreateOptsExt) ToServerCreateMap() (map[string]any, error) {
+	base, err := opts.CreateOptsBuilder.ToServerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.UserData == nil {
+		return base, nil
+	}
+
+	b, err := opts.UserData.ToUserData()
+	if err != nil {
+		return nil, err
+	}
+
+	serverMap := base["server"].(map[string]any)
+	serverMap["user_data"] = base64.StdEncoding.EncodeToString(b)
+
+	return base, nil
+}
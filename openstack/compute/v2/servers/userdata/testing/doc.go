@@ -0,0 +1,2 @@
+// Package testing includes userdata unit tests
+package testing
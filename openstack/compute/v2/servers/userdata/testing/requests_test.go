@@ -0,0 +1,220 @@
+package testing
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/servers/userdata"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+type part struct {
+	ContentType string
+	Filename    string
+	Content     string
+}
+
+func parse(t *testing.T, b []byte) []part {
+	t.Helper()
+
+	msg, err := mail.ReadMessage(bytes.NewReader(b))
+	th.AssertNoErr(t, err)
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "multipart/mixed", mediaType)
+
+	var parts []part
+	r := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		p, err := r.NextPart()
+		if err == io.EOF {
+			return parts
+		}
+		th.AssertNoErr(t, err)
+		content, err := io.ReadAll(p)
+		th.AssertNoErr(t, err)
+		contentType, _, err := mime.ParseMediaType(p.Header.Get("Content-Type"))
+		th.AssertNoErr(t, err)
+		parts = append(parts, part{ContentType: contentType, Filename: p.FileName(), Content: string(content)})
+	}
+}
+
+func TestToUserData(t *testing.T) {
+	lockPasswd := true
+	opts := userdata.Opts{
+		CloudConfig: &userdata.CloudConfig{
+			Hostname: "web-01",
+			Users: []userdata.User{
+				{Name: "default"},
+				{Name: "deploy", Shell: "/bin/bash", LockPasswd: &lockPasswd, SSHAuthorizedKeys: []string{"ssh-ed25519 AAAA deploy"}},
+			},
+			Packages: []string{"nginx"},
+			RunCmd:   []string{"systemctl enable --now nginx"},
+			Extra: map[string]any{
+				"ntp": map[string]any{"enabled": true},
+			},
+		},
+		PartHandlers: []userdata.Script{
+			{Filename: "handler.py", Content: "#part-handler\ndef list_types():\n    return []\n"},
+		},
+		IncludeURLs: []string{"https://example.com/a", "https://example.com/b"},
+		Scripts: []userdata.Script{
+			{Filename: "setup.sh", Content: "#!/bin/sh\necho setup\n"},
+			{Content: "#!/bin/sh\necho done\n"},
+		},
+		Boundary: "gophercloud",
+	}
+
+	b, err := opts.ToUserData()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, strings.HasPrefix(string(b), `Content-Type: multipart/mixed; boundary="gophercloud"`))
+
+	expected := []part{
+		{
+			ContentType: userdata.ContentTypePartHandler,
+			Filename:    "handler.py",
+			Content:     "#part-handler\ndef list_types():\n    return []\n",
+		},
+		{
+			ContentType: userdata.ContentTypeCloudConfig,
+			Filename:    "cloud-config.yaml",
+			Content: `#cloud-config
+hostname: web-01
+users:
+- name: default
+- name: deploy
+  shell: /bin/bash
+  lock_passwd: true
+  ssh_authorized_keys:
+  - ssh-ed25519 AAAA deploy
+packages:
+- nginx
+runcmd:
+- systemctl enable --now nginx
+ntp:
+  enabled: true
+`,
+		},
+		{
+			ContentType: userdata.ContentTypeIncludeURL,
+			Filename:    "part-003",
+			Content:     "#include\nhttps://example.com/a\nhttps://example.com/b\n",
+		},
+		{
+			ContentType: userdata.ContentTypeShellScript,
+			Filename:    "setup.sh",
+			Content:     "#!/bin/sh\necho setup\n",
+		},
+		{
+			ContentType: userdata.ContentTypeShellScript,
+			Filename:    "part-005",
+			Content:     "#!/bin/sh\necho done\n",
+		},
+	}
+	th.CheckDeepEquals(t, expected, parse(t, b))
+}
+
+func TestToUserDataNoPart(t *testing.T) {
+	_, err := userdata.Opts{}.ToUserData()
+	th.AssertErr(t, err)
+
+	opts := userdata.Opts{
+		Parts: []userdata.Part{{Content: []byte("data")}},
+	}
+	_, err = opts.ToUserData()
+	th.AssertErr(t, err)
+}
+
+func gunzip(t *testing.T, b []byte) []byte {
+	t.Helper()
+
+	zr, err := gzip.NewReader(bytes.NewReader(b))
+	th.AssertNoErr(t, err)
+	out, err := io.ReadAll(zr)
+	th.AssertNoErr(t, err)
+	return out
+}
+
+func TestToUserDataCompression(t *testing.T) {
+	script := "#!/bin/sh\n" + strings.Repeat("echo compressible\n", 200)
+	opts := userdata.Opts{
+		Scripts: []userdata.Script{{Content: script}},
+		MaxSize: 2048,
+	}
+
+	// Too large as is, so gzipped.
+	b, err := opts.ToUserData()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, userdata.EncodedLen(b) <= 2048)
+	parts := parse(t, gunzip(t, b))
+	th.AssertEquals(t, script, parts[0].Content)
+
+	// Small enough, so left as is.
+	opts.MaxSize = 0
+	b, err = opts.ToUserData()
+	th.AssertNoErr(t, err)
+	parts = parse(t, b)
+	th.AssertEquals(t, script, parts[0].Content)
+
+	opts.Compression = userdata.CompressAlways
+	b, err = opts.ToUserData()
+	th.AssertNoErr(t, err)
+	parse(t, gunzip(t, b))
+
+	opts.Compression = userdata.CompressNever
+	opts.MaxSize = 2048
+	_, err = opts.ToUserData()
+	var errTooLarge userdata.ErrTooLarge
+	th.AssertEquals(t, true, errors.As(err, &errTooLarge))
+	th.AssertEquals(t, 2048, errTooLarge.MaxSize)
+}
+
+func TestToUserDataTooLarge(t *testing.T) {
+	// Random data does not compress.
+	content := make([]byte, userdata.MaxSize)
+	_, err := rand.Read(content)
+	th.AssertNoErr(t, err)
+	opts := userdata.Opts{
+		Parts: []userdata.Part{{ContentType: "application/octet-stream", Content: []byte(base64.StdEncoding.EncodeToString(content))}},
+	}
+
+	_, err = opts.ToUserData()
+	var errTooLarge userdata.ErrTooLarge
+	th.AssertEquals(t, true, errors.As(err, &errTooLarge))
+	th.AssertEquals(t, userdata.MaxSize, errTooLarge.MaxSize)
+}
+
+func TestCreateOptsExt(t *testing.T) {
+	base := servers.CreateOpts{
+		Name:      "web-01",
+		ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
+		FlavorRef: "1",
+	}
+	ext := userdata.CreateOptsExt{
+		CreateOptsBuilder: base,
+		UserData: userdata.Opts{
+			CloudConfig: &userdata.CloudConfig{Hostname: "web-01"},
+			Boundary:    "gophercloud",
+		},
+	}
+
+	b, err := ext.ToServerCreateMap()
+	th.AssertNoErr(t, err)
+
+	encoded := b["server"].(map[string]any)["user_data"].(string)
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	th.AssertNoErr(t, err)
+	parts := parse(t, decoded)
+	th.AssertEquals(t, "#cloud-config\nhostname: web-01\n", parts[0].Content)
+	th.AssertEquals(t, "web-01", b["server"].(map[string]any)["name"])
+}
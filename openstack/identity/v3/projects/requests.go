@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
@@ -298,3 +299,15 @@ func DeleteTags(ctx context.Context, client *gophercloud.ServiceClient, projectI
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// IDFromName is a convenience function that returns a project's ID given its
+// name. The lookup is filtered by name server-side and matched exactly client-
+// side.
+//
+// It returns a gophercloud.ErrResourceNotFound if no project has that name and
+// a gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	return utils.ResolveID(ctx, List(client, ListOpts{Name: name}), ExtractProjects, func(p Project) (string, string) {
+		return p.Name, p.ID
+	}, "project", name)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
@@ -417,3 +418,15 @@ func (r UpdateImageProperty) ToImagePatchMap() map[string]any {
 
 	return updateMap
 }
+
+// IDFromName is a convenience function that returns an image's ID given its
+// name. The lookup is filtered by name server-side and matched exactly client-
+// side.
+//
+// It returns a gophercloud.ErrResourceNotFound if no image has that name and a
+// gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	return utils.ResolveID(ctx, List(client, ListOpts{Name: name}), ExtractImages, func(i Image) (string, string) {
+		return i.Name, i.ID
+	}, "image", name)
+}
@@ -2,9 +2,12 @@ package testing
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/image/v2/images"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
@@ -476,3 +479,53 @@ func TestUpdateImageProperties(t *testing.T) {
 
 	th.AssertDeepEquals(t, &expectedImage, actualImage)
 }
+
+func handleImageIDFromName(t *testing.T, body string) {
+	th.Mux.HandleFunc("/images", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fakeclient.TokenID)
+		th.TestFormValues(t, r, map[string]string{"name": "cirros"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, body)
+	})
+}
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleImageIDFromName(t, `{"images": [{"id": "07aa21a9-fa1a-430e-9a33-185be5982431", "name": "cirros"}]}`)
+
+	id, err := images.IDFromName(context.TODO(), fakeclient.ServiceClient(), "cirros")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "07aa21a9-fa1a-430e-9a33-185be5982431", id)
+}
+
+func TestIDFromNameNotFound(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleImageIDFromName(t, `{"images": []}`)
+
+	_, err := images.IDFromName(context.TODO(), fakeclient.ServiceClient(), "cirros")
+	if _, ok := err.(gophercloud.ErrResourceNotFound); !ok {
+		t.Fatalf("Expected ErrResourceNotFound, got %v", err)
+	}
+}
+
+func TestIDFromNameMultipleFound(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleImageIDFromName(t, `
+		{
+			"images": [
+				{"id": "07aa21a9-fa1a-430e-9a33-185be5982431", "name": "cirros"},
+				{"id": "8c64f48a-45a3-4eaa-adff-a8106b6c005b", "name": "cirros"}
+			]
+		}
+	`)
+
+	_, err := images.IDFromName(context.TODO(), fakeclient.ServiceClient(), "cirros")
+	if _, ok := err.(gophercloud.ErrMultipleResourcesFound); !ok {
+		t.Fatalf("Expected ErrMultipleResourcesFound, got %v", err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/vnpaycloud-console/gophercloud/v2"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/loadbalancer/v2/listeners"
 	"github.com/vnpaycloud-console/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
@@ -279,3 +280,15 @@ func Failover(ctx context.Context, c *gophercloud.ServiceClient, id string) (r F
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// IDFromName is a convenience function that returns a load balancer's ID given
+// its name. The lookup is filtered by name server-side and matched exactly
+// client-side.
+//
+// It returns a gophercloud.ErrResourceNotFound if no load balancer has that
+// name and a gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	return utils.ResolveID(ctx, List(client, ListOpts{Name: name}), ExtractLoadBalancers, func(lb LoadBalancer) (string, string) {
+		return lb.Name, lb.ID
+	}, "load balancer", name)
+}
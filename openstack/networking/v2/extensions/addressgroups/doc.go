@@ -0,0 +1,84 @@
+/*
+Package addressgroups contains functionality for working with Neutron address
+groups.
+
+An address group is a named set of IP addresses and CIDRs. A security group
+rule created with rules.CreateOpts.RemoteAddressGroupID matches every address
+of the group, so that an allowlist can be changed with AddAddresses and
+RemoveAddresses instead of rewriting the rules which use it.
+
+Example to List Address Groups
+
+	listOpts := addressgroups.ListOpts{
+		ProjectID: "a99e9b4e620e4db09a2dfb6e42a01e66",
+	}
+
+	allPages, err := addressgroups.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allGroups, err := addressgroups.ExtractAddressGroups(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, group := range allGroups {
+		fmt.Printf("%+v\n", group)
+	}
+
+Example to Create an Address Group and Use it in a Security Group Rule
+
+	createOpts := addressgroups.CreateOpts{
+		Name:      "office",
+		Addresses: []string{"192.0.2.0/24", "198.51.100.7/32"},
+	}
+
+	group, err := addressgroups.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	ruleOpts := rules.CreateOpts{
+		Direction:            rules.DirIngress,
+		EtherType:            rules.EtherType4,
+		SecGroupID:           "a7734e61-b545-452d-a3cd-0189cbd9747a",
+		Protocol:             rules.ProtocolTCP,
+		PortRangeMin:         22,
+		PortRangeMax:         22,
+		RemoteAddressGroupID: group.ID,
+	}
+
+	rule, err := rules.Create(context.TODO(), networkClient, ruleOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Add and Remove Addresses
+
+	addOpts := addressgroups.AddressesOpts{
+		Addresses: []string{"203.0.113.0/24"},
+	}
+
+	group, err := addressgroups.AddAddresses(context.TODO(), networkClient, groupID, addOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	removeOpts := addressgroups.AddressesOpts{
+		Addresses: []string{"198.51.100.7/32"},
+	}
+
+	group, err = addressgroups.RemoveAddresses(context.TODO(), networkClient, groupID, removeOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Address Group
+
+	err := addressgroups.Delete(context.TODO(), networkClient, groupID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package addressgroups
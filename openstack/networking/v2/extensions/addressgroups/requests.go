@@ -0,0 +1,187 @@
+package addressgroups
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToAddressGroupListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the address group attributes you want to see returned. SortKey allows you
+// to sort by a particular address group attribute. SortDir sets the
+// direction, and is either `asc' or `desc'. Marker and Limit are used for
+// pagination.
+type ListOpts struct {
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	TenantID    string `q:"tenant_id"`
+	ProjectID   string `q:"project_id"`
+	Limit       int    `q:"limit"`
+	Marker      string `q:"marker"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToAddressGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToAddressGroupListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// address groups. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToAddressGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return AddressGroupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific address group based on its unique ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, getURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToAddressGroupCreateMap() (map[string]any, error)
+}
+
+// CreateOpts represents options used to create an address group.
+type CreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	// Addresses are the IP addresses or CIDRs of the group.
+	Addresses []string `json:"addresses,omitempty"`
+
+	// ProjectID is the project which owns the address group. Only
+	// administrative users can specify a project other than their own.
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// ToAddressGroupCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToAddressGroupCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "address_group")
+}
+
+// Create accepts a CreateOpts struct and creates a new address group using
+// the values provided.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToAddressGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToAddressGroupUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts represents options used to update an address group. The
+// addresses of a group are changed with AddAddresses and RemoveAddresses.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToAddressGroupUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToAddressGroupUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "address_group")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing address group
+// using the values provided.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToAddressGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, updateURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete accepts a unique ID and deletes the address group associated with
+// it. An address group cannot be deleted while security group rules refer to
+// it.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, deleteURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// AddressesOptsBuilder allows extensions to add additional parameters to the
+// AddAddresses and RemoveAddresses requests.
+type AddressesOptsBuilder interface {
+	ToAddressGroupAddressesMap() (map[string]any, error)
+}
+
+// AddressesOpts represents the addresses to add to or remove from an address
+// group.
+type AddressesOpts struct {
+	Addresses []string `json:"addresses" required:"true"`
+}
+
+// ToAddressGroupAddressesMap builds a request body from AddressesOpts.
+func (opts AddressesOpts) ToAddressGroupAddressesMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// AddAddresses adds addresses to an address group. The security group rules
+// which refer to the group apply to the new addresses straight away.
+func AddAddresses(ctx context.Context, c *gophercloud.ServiceClient, id string, opts AddressesOptsBuilder) (r UpdateAddressesResult) {
+	b, err := opts.ToAddressGroupAddressesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, addAddressesURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// RemoveAddresses removes addresses from an address group.
+func RemoveAddresses(ctx context.Context, c *gophercloud.ServiceClient, id string, opts AddressesOptsBuilder) (r UpdateAddressesResult) {
+	b, err := opts.ToAddressGroupAddressesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, removeAddressesURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,134 @@
+package testing
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/addressgroups"
+)
+
+const ListResponse = `
+{
+    "address_groups": [
+        {
+            "id": "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f",
+            "name": "office",
+            "description": "Office egress addresses",
+            "addresses": ["192.0.2.0/24", "198.51.100.7/32"],
+            "shared": false,
+            "tenant_id": "45977fa2dbd7482098dd68d0d8970117",
+            "project_id": "45977fa2dbd7482098dd68d0d8970117"
+        },
+        {
+            "id": "e6b0ad30-4a2b-4bb2-8d33-6f0c3f1c2a18",
+            "name": "monitoring",
+            "description": "",
+            "addresses": ["203.0.113.10/32"],
+            "shared": true,
+            "tenant_id": "45977fa2dbd7482098dd68d0d8970117",
+            "project_id": "45977fa2dbd7482098dd68d0d8970117"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "address_group": {
+        "id": "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f",
+        "name": "office",
+        "description": "Office egress addresses",
+        "addresses": ["192.0.2.0/24", "198.51.100.7/32"],
+        "shared": false,
+        "tenant_id": "45977fa2dbd7482098dd68d0d8970117",
+        "project_id": "45977fa2dbd7482098dd68d0d8970117"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "address_group": {
+        "name": "office",
+        "description": "Office egress addresses",
+        "addresses": ["192.0.2.0/24", "198.51.100.7/32"]
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "address_group": {
+        "name": "head-office"
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "address_group": {
+        "id": "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f",
+        "name": "head-office",
+        "description": "Office egress addresses",
+        "addresses": ["192.0.2.0/24", "198.51.100.7/32"],
+        "shared": false,
+        "tenant_id": "45977fa2dbd7482098dd68d0d8970117",
+        "project_id": "45977fa2dbd7482098dd68d0d8970117"
+    }
+}
+`
+
+const AddAddressesRequest = `
+{
+    "addresses": ["203.0.113.0/24"]
+}
+`
+
+const AddAddressesResponse = `
+{
+    "address_group": {
+        "id": "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f",
+        "name": "office",
+        "description": "Office egress addresses",
+        "addresses": ["192.0.2.0/24", "198.51.100.7/32", "203.0.113.0/24"],
+        "shared": false,
+        "tenant_id": "45977fa2dbd7482098dd68d0d8970117",
+        "project_id": "45977fa2dbd7482098dd68d0d8970117"
+    }
+}
+`
+
+const RemoveAddressesRequest = `
+{
+    "addresses": ["198.51.100.7/32"]
+}
+`
+
+const RemoveAddressesResponse = `
+{
+    "address_group": {
+        "id": "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f",
+        "name": "office",
+        "description": "Office egress addresses",
+        "addresses": ["192.0.2.0/24"],
+        "shared": false,
+        "tenant_id": "45977fa2dbd7482098dd68d0d8970117",
+        "project_id": "45977fa2dbd7482098dd68d0d8970117"
+    }
+}
+`
+
+var OfficeGroup = addressgroups.AddressGroup{
+	ID:          "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f",
+	Name:        "office",
+	Description: "Office egress addresses",
+	Addresses:   []string{"192.0.2.0/24", "198.51.100.7/32"},
+	TenantID:    "45977fa2dbd7482098dd68d0d8970117",
+	ProjectID:   "45977fa2dbd7482098dd68d0d8970117",
+}
+
+var MonitoringGroup = addressgroups.AddressGroup{
+	ID:        "e6b0ad30-4a2b-4bb2-8d33-6f0c3f1c2a18",
+	Name:      "monitoring",
+	Addresses: []string{"203.0.113.10/32"},
+	Shared:    true,
+	TenantID:  "45977fa2dbd7482098dd68d0d8970117",
+	ProjectID: "45977fa2dbd7482098dd68d0d8970117",
+}
@@ -0,0 +1,169 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/addressgroups"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+
+	count := 0
+	err := addressgroups.List(fake.ServiceClient(), addressgroups.ListOpts{}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := addressgroups.ExtractAddressGroups(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []addressgroups.AddressGroup{OfficeGroup, MonitoringGroup}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups/9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	group, err := addressgroups.Get(context.TODO(), fake.ServiceClient(), "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &OfficeGroup, group)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	createOpts := addressgroups.CreateOpts{
+		Name:        "office",
+		Description: "Office egress addresses",
+		Addresses:   []string{"192.0.2.0/24", "198.51.100.7/32"},
+	}
+	group, err := addressgroups.Create(context.TODO(), fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &OfficeGroup, group)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups/9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	name := "head-office"
+	group, err := addressgroups.Update(context.TODO(), fake.ServiceClient(), "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f", addressgroups.UpdateOpts{Name: &name}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "head-office", group.Name)
+}
+
+func TestAddAddresses(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups/9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f/add_addresses", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, AddAddressesRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, AddAddressesResponse)
+	})
+
+	opts := addressgroups.AddressesOpts{Addresses: []string{"203.0.113.0/24"}}
+	group, err := addressgroups.AddAddresses(context.TODO(), fake.ServiceClient(), "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"192.0.2.0/24", "198.51.100.7/32", "203.0.113.0/24"}, group.Addresses)
+}
+
+func TestRemoveAddresses(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups/9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f/remove_addresses", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, RemoveAddressesRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, RemoveAddressesResponse)
+	})
+
+	opts := addressgroups.AddressesOpts{Addresses: []string{"198.51.100.7/32"}}
+	group, err := addressgroups.RemoveAddresses(context.TODO(), fake.ServiceClient(), "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"192.0.2.0/24"}, group.Addresses)
+}
+
+func TestRequiredAddressesOpts(t *testing.T) {
+	res := addressgroups.AddAddresses(context.TODO(), fake.ServiceClient(), "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f", addressgroups.AddressesOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/address-groups/9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := addressgroups.Delete(context.TODO(), fake.ServiceClient(), "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,39 @@
+/*
+Package autoallocatedtopology provides the ability to retrieve and delete the
+topology Neutron automatically allocates for a project, also known as
+"get me a network". The topology consists of a network, a subnet and a
+router connecting it to the default external network.
+
+Example to Validate the Requirements of an Auto-Allocated Topology
+
+	projectID := "a1b2c3d4e5f64a7b8c9d0e1f2a3b4c5d"
+	getOpts := autoallocatedtopology.GetOpts{
+		DryRun: true,
+	}
+
+	topology, err := autoallocatedtopology.Get(context.TODO(), networkClient, projectID, getOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(topology.ID == autoallocatedtopology.DryRunPass)
+
+Example to Get or Allocate an Auto-Allocated Topology
+
+	projectID := "a1b2c3d4e5f64a7b8c9d0e1f2a3b4c5d"
+	topology, err := autoallocatedtopology.Get(context.TODO(), networkClient, projectID, nil).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("network: %s\n", topology.ID)
+
+Example to Delete an Auto-Allocated Topology
+
+	projectID := "a1b2c3d4e5f64a7b8c9d0e1f2a3b4c5d"
+	err := autoallocatedtopology.Delete(context.TODO(), networkClient, projectID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package autoallocatedtopology
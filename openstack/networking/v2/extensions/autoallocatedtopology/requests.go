@@ -0,0 +1,53 @@
+package autoallocatedtopology
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// GetOptsBuilder allows extensions to add additional parameters to the
+// Get request.
+type GetOptsBuilder interface {
+	ToAutoAllocatedTopologyGetQuery() (string, error)
+}
+
+// GetOpts represents the options of a Get request.
+type GetOpts struct {
+	// DryRun checks that the requirements for the automatic allocation of a
+	// topology are met, without allocating anything. Neutron responds with
+	// a conflict if they are not.
+	DryRun bool
+}
+
+// ToAutoAllocatedTopologyGetQuery formats a GetOpts into a query string.
+func (opts GetOpts) ToAutoAllocatedTopologyGetQuery() (string, error) {
+	if opts.DryRun {
+		return "?fields=dry-run", nil
+	}
+	return "", nil
+}
+
+// Get retrieves the auto-allocated topology of a project, allocating it
+// first if the project does not have one yet.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, projectID string, opts GetOptsBuilder) (r GetResult) {
+	url := getURL(c, projectID)
+	if opts != nil {
+		query, err := opts.ToAutoAllocatedTopologyGetQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	resp, err := c.Get(ctx, url, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete removes the auto-allocated topology of a project.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, projectID string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, deleteURL(c, projectID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
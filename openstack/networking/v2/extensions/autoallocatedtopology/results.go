@@ -0,0 +1,46 @@
+package autoallocatedtopology
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// DryRunPass is the ID of the Topology returned by a dry-run request when the
+// requirements for its allocation are met.
+const DryRunPass = "dry-run=pass"
+
+// Topology represents the auto-allocated topology of a project.
+type Topology struct {
+	// ID is the ID of the network the project's servers are attached to. It
+	// is DryRunPass in response to a successful dry-run request.
+	ID string `json:"id"`
+
+	// ProjectID is the project owner of the topology.
+	ProjectID string `json:"project_id"`
+
+	// TenantID is the project owner of the topology.
+	TenantID string `json:"tenant_id"`
+}
+
+// GetResult represents the result of a Get operation. Call its Extract
+// method to interpret it as a Topology.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Topology.
+func (r GetResult) Extract() (*Topology, error) {
+	var s Topology
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtractInto extracts the auto_allocated_topology object into v.
+func (r GetResult) ExtractInto(v any) error {
+	return r.Result.ExtractIntoStructPtr(v, "auto_allocated_topology")
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,2 @@
+// Package testing includes auto-allocated topology unit tests
+package testing
@@ -0,0 +1,32 @@
+package testing
+
+const projectID = "a1b2c3d4e5f64a7b8c9d0e1f2a3b4c5d"
+
+const GetResponse = `
+{
+    "auto_allocated_topology": {
+        "id": "8a2ff7a4-9c6e-4b5b-9b0b-3d0c1b5e7f21",
+        "project_id": "a1b2c3d4e5f64a7b8c9d0e1f2a3b4c5d",
+        "tenant_id": "a1b2c3d4e5f64a7b8c9d0e1f2a3b4c5d"
+    }
+}
+`
+
+const DryRunResponse = `
+{
+    "auto_allocated_topology": {
+        "id": "dry-run=pass",
+        "tenant_id": "a1b2c3d4e5f64a7b8c9d0e1f2a3b4c5d"
+    }
+}
+`
+
+const DryRunConflictResponse = `
+{
+    "NeutronError": {
+        "type": "AutoAllocationFailure",
+        "message": "Deployment error: No default router:external network.",
+        "detail": ""
+    }
+}
+`
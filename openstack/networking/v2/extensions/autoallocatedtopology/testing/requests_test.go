@@ -0,0 +1,94 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/autoallocatedtopology"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/auto-allocated-topology/"+projectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	actual, err := autoallocatedtopology.Get(context.TODO(), fake.ServiceClient(), projectID, nil).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := &autoallocatedtopology.Topology{
+		ID:        "8a2ff7a4-9c6e-4b5b-9b0b-3d0c1b5e7f21",
+		ProjectID: projectID,
+		TenantID:  projectID,
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestGetDryRun(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/auto-allocated-topology/"+projectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"fields": "dry-run"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, DryRunResponse)
+	})
+
+	opts := autoallocatedtopology.GetOpts{DryRun: true}
+	actual, err := autoallocatedtopology.Get(context.TODO(), fake.ServiceClient(), projectID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, autoallocatedtopology.DryRunPass, actual.ID)
+	th.AssertEquals(t, projectID, actual.TenantID)
+}
+
+func TestGetDryRunConflict(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/auto-allocated-topology/"+projectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"fields": "dry-run"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+
+		fmt.Fprint(w, DryRunConflictResponse)
+	})
+
+	opts := autoallocatedtopology.GetOpts{DryRun: true}
+	_, err := autoallocatedtopology.Get(context.TODO(), fake.ServiceClient(), projectID, opts).Extract()
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusConflict))
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/auto-allocated-topology/"+projectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := autoallocatedtopology.Delete(context.TODO(), fake.ServiceClient(), projectID)
+	th.AssertNoErr(t, res.Err)
+}
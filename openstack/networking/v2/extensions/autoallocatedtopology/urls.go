@@ -0,0 +1,17 @@
+package autoallocatedtopology
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+const resourcePath = "auto-allocated-topology"
+
+func resourceURL(c *gophercloud.ServiceClient, projectID string) string {
+	return c.ServiceURL(resourcePath, projectID)
+}
+
+func getURL(c *gophercloud.ServiceClient, projectID string) string {
+	return resourceURL(c, projectID)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, projectID string) string {
+	return resourceURL(c, projectID)
+}
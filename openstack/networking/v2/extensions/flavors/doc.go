@@ -0,0 +1,88 @@
+/*
+Package flavors contains functionality for working with Neutron flavors.
+
+A flavor lets users choose between tiers of a service, such as HA and
+non-HA routers, without knowing how they are implemented. A flavor is
+implemented by the service profiles associated with it, see the
+serviceprofiles package. Users select a flavor with the FlavorID field of
+e.g. routers.CreateOpts.
+
+Example to List Flavors
+
+	listOpts := flavors.ListOpts{
+		ServiceType: flavors.ServiceTypeRouter,
+	}
+
+	allPages, err := flavors.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allFlavors, err := flavors.ExtractFlavors(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, flavor := range allFlavors {
+		fmt.Printf("%+v\n", flavor)
+	}
+
+Example to Create a Flavor
+
+	createOpts := flavors.CreateOpts{
+		Name:        "ha-router",
+		Description: "Highly available router",
+		ServiceType: flavors.ServiceTypeRouter,
+	}
+
+	flavor, err := flavors.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Flavor
+
+	enabled := false
+	updateOpts := flavors.UpdateOpts{
+		Enabled: &enabled,
+	}
+
+	flavor, err := flavors.Update(context.TODO(), networkClient, flavorID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Associate a Service Profile with a Flavor
+
+	err := flavors.AssociateServiceProfile(context.TODO(), networkClient, flavorID, profileID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Disassociate a Service Profile from a Flavor
+
+	err := flavors.DisassociateServiceProfile(context.TODO(), networkClient, flavorID, profileID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Router with a Flavor
+
+	createOpts := routers.CreateOpts{
+		Name:     "router1",
+		FlavorID: flavorID,
+	}
+
+	router, err := routers.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Flavor
+
+	err := flavors.Delete(context.TODO(), networkClient, flavorID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package flavors
@@ -0,0 +1,161 @@
+package flavors
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToFlavorListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the flavor attributes you want to see returned. SortKey allows you to sort
+// by a particular flavor attribute. SortDir sets the direction, and is either
+// `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	ServiceType string `q:"service_type"`
+	Enabled     *bool  `q:"enabled"`
+	Limit       int    `q:"limit"`
+	Marker      string `q:"marker"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToFlavorListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToFlavorListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// flavors. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToFlavorListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return FlavorPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific flavor based on its unique ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, getURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToFlavorCreateMap() (map[string]any, error)
+}
+
+// CreateOpts represents options used to create a flavor.
+type CreateOpts struct {
+	Name        string `json:"name" required:"true"`
+	Description string `json:"description,omitempty"`
+
+	// ServiceType is the service the flavor applies to, such as
+	// ServiceTypeRouter.
+	ServiceType string `json:"service_type" required:"true"`
+
+	// Enabled is whether the flavor can be used. It defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ToFlavorCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToFlavorCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "flavor")
+}
+
+// Create accepts a CreateOpts struct and creates a new flavor using the
+// values provided. Only administrative users can create flavors.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToFlavorCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToFlavorUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts represents options used to update a flavor. The service type of
+// a flavor cannot be changed.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// ToFlavorUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToFlavorUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "flavor")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing flavor using the
+// values provided.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToFlavorUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, updateURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete accepts a unique ID and deletes the flavor associated with it. A
+// flavor cannot be deleted while resources use it.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, deleteURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// AssociateServiceProfile associates a service profile with a flavor, making
+// the profile's driver available to the resources created with the flavor.
+func AssociateServiceProfile(ctx context.Context, c *gophercloud.ServiceClient, id, profileID string) (r AssociateResult) {
+	b := map[string]any{
+		"service_profile": map[string]any{
+			"id": profileID,
+		},
+	}
+	resp, err := c.Post(ctx, associateURL(c, id), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DisassociateServiceProfile removes the association between a flavor and a
+// service profile.
+func DisassociateServiceProfile(ctx context.Context, c *gophercloud.ServiceClient, id, profileID string) (r DisassociateResult) {
+	resp, err := c.Delete(ctx, disassociateURL(c, id, profileID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,131 @@
+package flavors
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// Service types of the resources flavors apply to.
+const (
+	ServiceTypeRouter       = "L3_ROUTER_NAT"
+	ServiceTypeLoadBalancer = "LOADBALANCERV2"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Flavor.
+func (r commonResult) Extract() (*Flavor, error) {
+	var s Flavor
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v any) error {
+	return r.Result.ExtractIntoStructPtr(v, "flavor")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a Flavor.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Flavor.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a Flavor.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AssociateResult represents the result of an AssociateServiceProfile
+// operation. Call its ExtractErr method to determine if the request
+// succeeded or failed.
+type AssociateResult struct {
+	gophercloud.ErrResult
+}
+
+// DisassociateResult represents the result of a DisassociateServiceProfile
+// operation. Call its ExtractErr method to determine if the request
+// succeeded or failed.
+type DisassociateResult struct {
+	gophercloud.ErrResult
+}
+
+// Flavor represents a Neutron flavor, a tier of a service such as routers
+// which users can choose from when they create a resource.
+type Flavor struct {
+	// ID is the UUID of the flavor.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the flavor.
+	Name string `json:"name"`
+
+	// Description of the flavor.
+	Description string `json:"description"`
+
+	// ServiceType is the service the flavor applies to.
+	ServiceType string `json:"service_type"`
+
+	// Enabled is whether the flavor can be used.
+	Enabled bool `json:"enabled"`
+
+	// ServiceProfiles are the IDs of the service profiles associated with
+	// the flavor.
+	ServiceProfiles []string `json:"service_profiles"`
+}
+
+// FlavorPage is the page returned by a pager when traversing over a
+// collection of flavors.
+type FlavorPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of flavors has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r FlavorPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"flavors_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a FlavorPage struct is empty.
+func (r FlavorPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractFlavors(r)
+	return len(is) == 0, err
+}
+
+// ExtractFlavors accepts a Page struct, specifically a FlavorPage struct, and
+// extracts the elements into a slice of Flavor structs.
+func ExtractFlavors(r pagination.Page) ([]Flavor, error) {
+	var s []Flavor
+	err := ExtractFlavorsInto(r, &s)
+	return s, err
+}
+
+// ExtractFlavorsInto extracts the elements into a slice of Flavor structs.
+func ExtractFlavorsInto(r pagination.Page, v any) error {
+	return r.(FlavorPage).Result.ExtractIntoSlicePtr(v, "flavors")
+}
@@ -0,0 +1,2 @@
+// Package testing includes flavors unit tests
+package testing
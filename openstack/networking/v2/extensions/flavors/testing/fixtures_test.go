@@ -0,0 +1,104 @@
+package testing
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/flavors"
+)
+
+const ListResponse = `
+{
+    "flavors": [
+        {
+            "id": "2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f",
+            "name": "ha-router",
+            "description": "Highly available router",
+            "service_type": "L3_ROUTER_NAT",
+            "enabled": true,
+            "service_profiles": ["7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13"]
+        },
+        {
+            "id": "c9e5a1b2-6d3f-4e7a-8b0c-1d2e3f4a5b6c",
+            "name": "single-router",
+            "description": "",
+            "service_type": "L3_ROUTER_NAT",
+            "enabled": false,
+            "service_profiles": []
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "flavor": {
+        "id": "2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f",
+        "name": "ha-router",
+        "description": "Highly available router",
+        "service_type": "L3_ROUTER_NAT",
+        "enabled": true,
+        "service_profiles": ["7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13"]
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "flavor": {
+        "name": "ha-router",
+        "description": "Highly available router",
+        "service_type": "L3_ROUTER_NAT"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "flavor": {
+        "enabled": false
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "flavor": {
+        "id": "2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f",
+        "name": "ha-router",
+        "description": "Highly available router",
+        "service_type": "L3_ROUTER_NAT",
+        "enabled": false,
+        "service_profiles": ["7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13"]
+    }
+}
+`
+
+const AssociateRequest = `
+{
+    "service_profile": {
+        "id": "7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13"
+    }
+}
+`
+
+const AssociateResponse = `
+{
+    "service_profile": {
+        "id": "7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13"
+    }
+}
+`
+
+var HAFlavor = flavors.Flavor{
+	ID:              "2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f",
+	Name:            "ha-router",
+	Description:     "Highly available router",
+	ServiceType:     flavors.ServiceTypeRouter,
+	Enabled:         true,
+	ServiceProfiles: []string{"7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13"},
+}
+
+var SingleFlavor = flavors.Flavor{
+	ID:              "c9e5a1b2-6d3f-4e7a-8b0c-1d2e3f4a5b6c",
+	Name:            "single-router",
+	ServiceType:     flavors.ServiceTypeRouter,
+	ServiceProfiles: []string{},
+}
@@ -0,0 +1,162 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/flavors"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/flavors", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"service_type": "L3_ROUTER_NAT"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+
+	count := 0
+	listOpts := flavors.ListOpts{ServiceType: flavors.ServiceTypeRouter}
+	err := flavors.List(fake.ServiceClient(), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := flavors.ExtractFlavors(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []flavors.Flavor{HAFlavor, SingleFlavor}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/flavors/2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	flavor, err := flavors.Get(context.TODO(), fake.ServiceClient(), "2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &HAFlavor, flavor)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/flavors", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	createOpts := flavors.CreateOpts{
+		Name:        "ha-router",
+		Description: "Highly available router",
+		ServiceType: flavors.ServiceTypeRouter,
+	}
+	flavor, err := flavors.Create(context.TODO(), fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &HAFlavor, flavor)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := flavors.Create(context.TODO(), fake.ServiceClient(), flavors.CreateOpts{Name: "ha-router"})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/flavors/2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	enabled := false
+	flavor, err := flavors.Update(context.TODO(), fake.ServiceClient(), "2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f", flavors.UpdateOpts{Enabled: &enabled}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, flavor.Enabled)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/flavors/2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := flavors.Delete(context.TODO(), fake.ServiceClient(), "2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestAssociateServiceProfile(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/flavors/2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f/service_profiles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, AssociateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, AssociateResponse)
+	})
+
+	err := flavors.AssociateServiceProfile(context.TODO(), fake.ServiceClient(), "2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f", "7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDisassociateServiceProfile(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/flavors/2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f/service_profiles/7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := flavors.DisassociateServiceProfile(context.TODO(), fake.ServiceClient(), "2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f", "7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13").ExtractErr()
+	th.AssertNoErr(t, err)
+}
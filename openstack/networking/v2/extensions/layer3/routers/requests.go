@@ -65,6 +65,10 @@ type CreateOpts struct {
 	ProjectID             string       `json:"project_id,omitempty"`
 	GatewayInfo           *GatewayInfo `json:"external_gateway_info,omitempty"`
 	AvailabilityZoneHints []string     `json:"availability_zone_hints,omitempty"`
+
+	// FlavorID is the Neutron flavor of the router, e.g. to choose between
+	// HA and non-HA routers. It cannot be changed after creation.
+	FlavorID string `json:"flavor_id,omitempty"`
 }
 
 // ToRouterCreateMap builds a create request body from CreateOpts.
@@ -75,6 +75,9 @@ type Router struct {
 	// Used to make network resources highly available.
 	AvailabilityZoneHints []string `json:"availability_zone_hints"`
 
+	// FlavorID is the ID of the Neutron flavor the router was created with.
+	FlavorID string `json:"flavor_id"`
+
 	// Tags optionally set via extensions/attributestags
 	Tags []string `json:"tags"`
 }
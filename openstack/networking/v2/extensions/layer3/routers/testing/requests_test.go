@@ -213,6 +213,50 @@ func TestCreate(t *testing.T) {
 	th.AssertDeepEquals(t, []string{"zone1", "zone2"}, r.AvailabilityZoneHints)
 }
 
+func TestCreateWithFlavor(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/routers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+   "router":{
+      "name": "ha_router",
+      "flavor_id": "2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f"
+   }
+}
+			`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, `
+{
+    "router": {
+        "status": "ACTIVE",
+        "name": "ha_router",
+        "admin_state_up": true,
+        "tenant_id": "6b96ff0cb17a4b859e1e575d221683d3",
+        "flavor_id": "2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f",
+        "id": "8604a0de-7f6b-409a-a47c-a1cc7bc77b2e"
+    }
+}
+		`)
+	})
+
+	options := routers.CreateOpts{
+		Name:     "ha_router",
+		FlavorID: "2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f",
+	}
+	r, err := routers.Create(context.TODO(), fake.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "ha_router", r.Name)
+	th.AssertEquals(t, "2f3d4a77-7e8b-4c5a-9a5e-1f0b7c2d3e4f", r.FlavorID)
+}
+
 func TestGet(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
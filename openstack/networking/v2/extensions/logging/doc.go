@@ -0,0 +1,4 @@
+// Package logging provides information and interaction with the Logging
+// extension for the OpenStack Networking service, which records the packets
+// accepted or dropped by security groups and firewall groups.
+package logging
@@ -0,0 +1,21 @@
+/*
+Package loggableresources lists the types of resource whose traffic can be
+logged by the Logging extension for the OpenStack Networking service.
+
+Example to List Loggable Resources
+
+	allPages, err := loggableresources.List(networkClient).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	resources, err := loggableresources.ExtractLoggableResources(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, r := range resources {
+		fmt.Println(r.Type)
+	}
+*/
+package loggableresources
@@ -0,0 +1,14 @@
+package loggableresources
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// List returns a Pager which allows you to iterate over the types of resource
+// whose traffic can be logged.
+func List(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, listURL(c), func(r pagination.PageResult) pagination.Page {
+		return LoggableResourcePage{pagination.SinglePageBase(r)}
+	})
+}
@@ -0,0 +1,2 @@
+// Package testing includes loggable resources unit tests
+package testing
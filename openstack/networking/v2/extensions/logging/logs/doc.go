@@ -0,0 +1,64 @@
+/*
+Package logs provides information and interaction with the logs of the
+Logging extension for the OpenStack Networking service.
+
+A log records the packets accepted or dropped by a security group, from
+extensions/security/groups, or by a firewall group, from
+extensions/fwaas_v2/groups.
+
+Example to List the Logs of a Security Group
+
+	listOpts := logs.ListOpts{
+		ResourceType: logs.ResourceTypeSecurityGroup,
+		ResourceID:   "c3a8f2e1-5b64-4c8b-9c3a-9a2f7c1d5e44",
+	}
+
+	allPages, err := logs.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allLogs, err := logs.ExtractLogs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Log the Dropped Packets of a Firewall Group
+
+	group, err := groups.Get(context.TODO(), networkClient, groupID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := logs.CreateOpts{
+		Name:         "fw-drops",
+		ResourceType: logs.ResourceTypeFirewallGroup,
+		ResourceID:   group.ID,
+		Event:        logs.EventDrop,
+	}
+
+	log, err := logs.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Disable a Log
+
+	enabled := false
+	updateOpts := logs.UpdateOpts{
+		Enabled: &enabled,
+	}
+
+	log, err := logs.Update(context.TODO(), networkClient, logID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Log
+
+	err := logs.Delete(context.TODO(), networkClient, logID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package logs
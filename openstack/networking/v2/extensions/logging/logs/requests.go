@@ -0,0 +1,192 @@
+package logs
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+type (
+	// ResourceType represents the type of resource whose traffic is logged.
+	ResourceType string
+
+	// Event represents the kind of packets that are logged.
+	Event string
+)
+
+const (
+	// ResourceTypeSecurityGroup logs the traffic of security groups. The
+	// ResourceID of such a log is the ID of a security group from
+	// extensions/security/groups.
+	ResourceTypeSecurityGroup ResourceType = "security_group"
+
+	// ResourceTypeFirewallGroup logs the traffic of firewall groups. The
+	// ResourceID of such a log is the ID of a firewall group from
+	// extensions/fwaas_v2/groups.
+	ResourceTypeFirewallGroup ResourceType = "firewall_group"
+)
+
+const (
+	// EventAll logs both accepted and dropped packets.
+	EventAll Event = "ALL"
+
+	// EventAccept logs accepted packets.
+	EventAccept Event = "ACCEPT"
+
+	// EventDrop logs dropped packets.
+	EventDrop Event = "DROP"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToLogListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the log attributes you want to see returned. SortKey allows you to sort by
+// a particular log attribute. SortDir sets the direction, and is either `asc'
+// or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID             string       `q:"id"`
+	Name           string       `q:"name"`
+	Description    string       `q:"description"`
+	ResourceType   ResourceType `q:"resource_type"`
+	ResourceID     string       `q:"resource_id"`
+	TargetID       string       `q:"target_id"`
+	Event          Event        `q:"event"`
+	Enabled        *bool        `q:"enabled"`
+	TenantID       string       `q:"tenant_id"`
+	ProjectID      string       `q:"project_id"`
+	RevisionNumber *int         `q:"revision_number"`
+	Limit          int          `q:"limit"`
+	Marker         string       `q:"marker"`
+	SortKey        string       `q:"sort_key"`
+	SortDir        string       `q:"sort_dir"`
+}
+
+// ToLogListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToLogListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// logs. It accepts a ListOpts struct, which allows you to filter and sort the
+// returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+
+	if opts != nil {
+		query, err := opts.ToLogListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return LogPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToLogCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new log.
+type CreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	// ResourceType is the type of resource whose traffic is logged.
+	ResourceType ResourceType `json:"resource_type" required:"true"`
+
+	// ResourceID restricts the log to a single security group or firewall
+	// group. All resources of ResourceType are logged when it is empty.
+	ResourceID string `json:"resource_id,omitempty"`
+
+	// TargetID restricts the log to the traffic of a single port.
+	TargetID string `json:"target_id,omitempty"`
+
+	// Event is the kind of packets that are logged. Neutron defaults to
+	// EventAll.
+	Event Event `json:"event,omitempty"`
+
+	// Enabled turns the log on or off. Neutron defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	TenantID  string `json:"tenant_id,omitempty"`
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// ToLogCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToLogCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "log")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new log.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToLogCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves a particular log based on its unique ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToLogUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contains the values used when updating a log. The resource and
+// event of a log cannot be changed.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// ToLogUpdateMap casts a UpdateOpts struct to a map.
+func (opts UpdateOpts) ToLogUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "log")
+}
+
+// Update allows logs to be updated.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToLogUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will permanently delete a particular log based on its unique ID.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
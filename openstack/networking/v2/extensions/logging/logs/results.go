@@ -0,0 +1,101 @@
+package logs
+
+import (
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// Log represents a network log.
+type Log struct {
+	ID           string       `json:"id"`
+	Name         string       `json:"name"`
+	Description  string       `json:"description"`
+	ResourceType ResourceType `json:"resource_type"`
+	ResourceID   string       `json:"resource_id"`
+	TargetID     string       `json:"target_id"`
+	Event        Event        `json:"event"`
+	Enabled      bool         `json:"enabled"`
+	TenantID     string       `json:"tenant_id"`
+	ProjectID    string       `json:"project_id"`
+
+	// RevisionNumber optionally set via extensions/standard-attr-revisions
+	RevisionNumber int `json:"revision_number"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// LogPage is the page returned by a pager when traversing over a collection
+// of logs.
+type LogPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of logs has reached the
+// end of a page and the pager seeks to traverse over a new one. In order to
+// do this, it needs to construct the next page's URL.
+func (r LogPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"logs_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a LogPage struct is empty.
+func (r LogPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractLogs(r)
+	return len(is) == 0, err
+}
+
+// ExtractLogs accepts a Page struct, specifically a LogPage struct, and
+// extracts the elements into a slice of Log structs.
+func ExtractLogs(r pagination.Page) ([]Log, error) {
+	var s struct {
+		Logs []Log `json:"logs"`
+	}
+	err := (r.(LogPage)).ExtractInto(&s)
+	return s.Logs, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a log.
+func (r commonResult) Extract() (*Log, error) {
+	var s struct {
+		Log *Log `json:"log"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Log, err
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,2 @@
+// Package testing includes network log unit tests
+package testing
@@ -0,0 +1,131 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/logging/logs"
+)
+
+const ListResponse = `
+{
+    "logs": [
+        {
+            "id": "30f2b3a5-4a8e-4f6b-b8c4-2f7e1d0c9a11",
+            "name": "sg-all",
+            "description": "",
+            "resource_type": "security_group",
+            "resource_id": "c3a8f2e1-5b64-4c8b-9c3a-9a2f7c1d5e44",
+            "target_id": null,
+            "event": "ALL",
+            "enabled": true,
+            "tenant_id": "8d4c70a21fed4aeba121a1a429ba0d04",
+            "project_id": "8d4c70a21fed4aeba121a1a429ba0d04",
+            "revision_number": 0,
+            "created_at": "2024-05-02T08:00:00Z",
+            "updated_at": "2024-05-02T08:00:00Z"
+        },
+        {
+            "id": "5f1d7e9b-6c3a-4d2e-8b1f-0a9c8d7e6f55",
+            "name": "fw-drops",
+            "description": "Dropped packets on the web port",
+            "resource_type": "firewall_group",
+            "resource_id": "d6b3e1f4-2a5c-4e8d-9f7b-1c0a3e5d7b66",
+            "target_id": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+            "event": "DROP",
+            "enabled": false,
+            "tenant_id": "8d4c70a21fed4aeba121a1a429ba0d04",
+            "project_id": "8d4c70a21fed4aeba121a1a429ba0d04",
+            "revision_number": 2,
+            "created_at": "2024-05-02T09:00:00Z",
+            "updated_at": "2024-05-03T10:30:00Z"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "log": {
+        "id": "30f2b3a5-4a8e-4f6b-b8c4-2f7e1d0c9a11",
+        "name": "sg-all",
+        "description": "",
+        "resource_type": "security_group",
+        "resource_id": "c3a8f2e1-5b64-4c8b-9c3a-9a2f7c1d5e44",
+        "target_id": null,
+        "event": "ALL",
+        "enabled": true,
+        "tenant_id": "8d4c70a21fed4aeba121a1a429ba0d04",
+        "project_id": "8d4c70a21fed4aeba121a1a429ba0d04",
+        "revision_number": 0,
+        "created_at": "2024-05-02T08:00:00Z",
+        "updated_at": "2024-05-02T08:00:00Z"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "log": {
+        "name": "sg-all",
+        "resource_type": "security_group",
+        "resource_id": "c3a8f2e1-5b64-4c8b-9c3a-9a2f7c1d5e44",
+        "event": "ALL"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "log": {
+        "enabled": false
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "log": {
+        "id": "30f2b3a5-4a8e-4f6b-b8c4-2f7e1d0c9a11",
+        "name": "sg-all",
+        "description": "",
+        "resource_type": "security_group",
+        "resource_id": "c3a8f2e1-5b64-4c8b-9c3a-9a2f7c1d5e44",
+        "target_id": null,
+        "event": "ALL",
+        "enabled": false,
+        "tenant_id": "8d4c70a21fed4aeba121a1a429ba0d04",
+        "project_id": "8d4c70a21fed4aeba121a1a429ba0d04",
+        "revision_number": 1,
+        "created_at": "2024-05-02T08:00:00Z",
+        "updated_at": "2024-05-04T12:00:00Z"
+    }
+}
+`
+
+var SecurityGroupLog = logs.Log{
+	ID:           "30f2b3a5-4a8e-4f6b-b8c4-2f7e1d0c9a11",
+	Name:         "sg-all",
+	ResourceType: logs.ResourceTypeSecurityGroup,
+	ResourceID:   "c3a8f2e1-5b64-4c8b-9c3a-9a2f7c1d5e44",
+	Event:        logs.EventAll,
+	Enabled:      true,
+	TenantID:     "8d4c70a21fed4aeba121a1a429ba0d04",
+	ProjectID:    "8d4c70a21fed4aeba121a1a429ba0d04",
+	CreatedAt:    time.Date(2024, 5, 2, 8, 0, 0, 0, time.UTC),
+	UpdatedAt:    time.Date(2024, 5, 2, 8, 0, 0, 0, time.UTC),
+}
+
+var FirewallGroupLog = logs.Log{
+	ID:             "5f1d7e9b-6c3a-4d2e-8b1f-0a9c8d7e6f55",
+	Name:           "fw-drops",
+	Description:    "Dropped packets on the web port",
+	ResourceType:   logs.ResourceTypeFirewallGroup,
+	ResourceID:     "d6b3e1f4-2a5c-4e8d-9f7b-1c0a3e5d7b66",
+	TargetID:       "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+	Event:          logs.EventDrop,
+	TenantID:       "8d4c70a21fed4aeba121a1a429ba0d04",
+	ProjectID:      "8d4c70a21fed4aeba121a1a429ba0d04",
+	RevisionNumber: 2,
+	CreatedAt:      time.Date(2024, 5, 2, 9, 0, 0, 0, time.UTC),
+	UpdatedAt:      time.Date(2024, 5, 3, 10, 30, 0, 0, time.UTC),
+}
@@ -0,0 +1,130 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/logging/logs"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/log/logs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"project_id": "8d4c70a21fed4aeba121a1a429ba0d04"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+
+	count := 0
+	err := logs.List(fake.ServiceClient(), logs.ListOpts{ProjectID: "8d4c70a21fed4aeba121a1a429ba0d04"}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := logs.ExtractLogs(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []logs.Log{SecurityGroupLog, FirewallGroupLog}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/log/logs/30f2b3a5-4a8e-4f6b-b8c4-2f7e1d0c9a11", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	log, err := logs.Get(context.TODO(), fake.ServiceClient(), "30f2b3a5-4a8e-4f6b-b8c4-2f7e1d0c9a11").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &SecurityGroupLog, log)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/log/logs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	createOpts := logs.CreateOpts{
+		Name:         "sg-all",
+		ResourceType: logs.ResourceTypeSecurityGroup,
+		ResourceID:   "c3a8f2e1-5b64-4c8b-9c3a-9a2f7c1d5e44",
+		Event:        logs.EventAll,
+	}
+	log, err := logs.Create(context.TODO(), fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &SecurityGroupLog, log)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := logs.Create(context.TODO(), fake.ServiceClient(), logs.CreateOpts{Name: "sg-all"})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/log/logs/30f2b3a5-4a8e-4f6b-b8c4-2f7e1d0c9a11", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	enabled := false
+	log, err := logs.Update(context.TODO(), fake.ServiceClient(), "30f2b3a5-4a8e-4f6b-b8c4-2f7e1d0c9a11", logs.UpdateOpts{Enabled: &enabled}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, log.Enabled)
+	th.AssertEquals(t, 1, log.RevisionNumber)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/log/logs/30f2b3a5-4a8e-4f6b-b8c4-2f7e1d0c9a11", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := logs.Delete(context.TODO(), fake.ServiceClient(), "30f2b3a5-4a8e-4f6b-b8c4-2f7e1d0c9a11").ExtractErr()
+	th.AssertNoErr(t, err)
+}
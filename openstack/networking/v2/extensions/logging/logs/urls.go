@@ -0,0 +1,16 @@
+package logs
+
+import "github.com/vnpaycloud-console/gophercloud/v2"
+
+const (
+	rootPath     = "log"
+	resourcePath = "logs"
+)
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, resourcePath, id)
+}
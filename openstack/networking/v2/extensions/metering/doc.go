@@ -0,0 +1,4 @@
+// Package metering provides information and interaction with the Metering
+// extension for the OpenStack Networking service, which measures the layer 3
+// traffic of routers.
+package metering
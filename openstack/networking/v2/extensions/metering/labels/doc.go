@@ -0,0 +1,47 @@
+/*
+Package labels provides information and interaction with the metering labels
+of the Metering extension for the OpenStack Networking service.
+
+Example to List Metering Labels
+
+	listOpts := labels.ListOpts{
+		ProjectID: "a99e9b4e620e4db09a2dfb6e42a01e66",
+	}
+
+	allPages, err := labels.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allLabels, err := labels.ExtractLabels(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, label := range allLabels {
+		fmt.Printf("%+v\n", label)
+	}
+
+Example to Create a Shared Metering Label
+
+	shared := true
+	createOpts := labels.CreateOpts{
+		Name:        "internet-egress",
+		Description: "Traffic to the internet",
+		Shared:      &shared,
+	}
+
+	label, err := labels.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Metering Label
+
+	labelID := "bc91b832-8465-40a7-a5d8-ba87de442266"
+	err := labels.Delete(context.TODO(), networkClient, labelID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package labels
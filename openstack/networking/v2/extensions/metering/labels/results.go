@@ -0,0 +1,86 @@
+package labels
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// Label represents a metering label. The traffic of the routers of the
+// label's project, or of every project if the label is shared, is measured
+// against the label's rules.
+type Label struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Shared      bool   `json:"shared"`
+	TenantID    string `json:"tenant_id"`
+	ProjectID   string `json:"project_id"`
+}
+
+// LabelPage is the page returned by a pager when traversing over a
+// collection of metering labels.
+type LabelPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of metering labels has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r LabelPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"metering_labels_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a LabelPage struct is empty.
+func (r LabelPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractLabels(r)
+	return len(is) == 0, err
+}
+
+// ExtractLabels accepts a Page struct, specifically a LabelPage struct, and
+// extracts the elements into a slice of Label structs.
+func ExtractLabels(r pagination.Page) ([]Label, error) {
+	var s struct {
+		Labels []Label `json:"metering_labels"`
+	}
+	err := (r.(LabelPage)).ExtractInto(&s)
+	return s.Labels, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a metering label.
+func (r commonResult) Extract() (*Label, error) {
+	var s struct {
+		Label *Label `json:"metering_label"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Label, err
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
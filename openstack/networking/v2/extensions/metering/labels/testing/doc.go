@@ -0,0 +1,2 @@
+// Package testing includes metering label unit tests
+package testing
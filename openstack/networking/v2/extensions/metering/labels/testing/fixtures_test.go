@@ -0,0 +1,68 @@
+package testing
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/metering/labels"
+)
+
+const ListResponse = `
+{
+    "metering_labels": [
+        {
+            "id": "a6700594-5b7a-4105-8bfe-723b346ce866",
+            "name": "label1",
+            "description": "description of label1",
+            "shared": false,
+            "tenant_id": "45345b0ee1ea477fac0f541b2cb79cd4",
+            "project_id": "45345b0ee1ea477fac0f541b2cb79cd4"
+        },
+        {
+            "id": "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+            "name": "label2",
+            "description": "description of label2",
+            "shared": true,
+            "tenant_id": "45345b0ee1ea477fac0f541b2cb79cd4",
+            "project_id": "45345b0ee1ea477fac0f541b2cb79cd4"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "metering_label": {
+        "id": "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+        "name": "label2",
+        "description": "description of label2",
+        "shared": true,
+        "tenant_id": "45345b0ee1ea477fac0f541b2cb79cd4",
+        "project_id": "45345b0ee1ea477fac0f541b2cb79cd4"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "metering_label": {
+        "name": "label2",
+        "description": "description of label2",
+        "shared": true
+    }
+}
+`
+
+var Label1 = labels.Label{
+	ID:          "a6700594-5b7a-4105-8bfe-723b346ce866",
+	Name:        "label1",
+	Description: "description of label1",
+	TenantID:    "45345b0ee1ea477fac0f541b2cb79cd4",
+	ProjectID:   "45345b0ee1ea477fac0f541b2cb79cd4",
+}
+
+var Label2 = labels.Label{
+	ID:          "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+	Name:        "label2",
+	Description: "description of label2",
+	Shared:      true,
+	TenantID:    "45345b0ee1ea477fac0f541b2cb79cd4",
+	ProjectID:   "45345b0ee1ea477fac0f541b2cb79cd4",
+}
@@ -0,0 +1,55 @@
+/*
+Package rules provides information and interaction with the metering label
+rules of the Metering extension for the OpenStack Networking service.
+
+Example to List the Rules of a Metering Label
+
+	listOpts := rules.ListOpts{
+		MeteringLabelID: "bc91b832-8465-40a7-a5d8-ba87de442266",
+	}
+
+	allPages, err := rules.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allRules, err := rules.ExtractRules(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Count Egress Traffic Except to the Private Network
+
+	createOpts := rules.CreateOpts{
+		MeteringLabelID:     "bc91b832-8465-40a7-a5d8-ba87de442266",
+		Direction:           rules.DirectionEgress,
+		DestinationIPPrefix: "0.0.0.0/0",
+	}
+
+	rule, err := rules.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	excluded := true
+	createOpts = rules.CreateOpts{
+		MeteringLabelID:     "bc91b832-8465-40a7-a5d8-ba87de442266",
+		Direction:           rules.DirectionEgress,
+		Excluded:            &excluded,
+		DestinationIPPrefix: "10.0.0.0/8",
+	}
+
+	rule, err = rules.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Metering Label Rule
+
+	ruleID := "9536641a-7d14-4dc5-afaf-93a973ce0eb8"
+	err := rules.Delete(context.TODO(), networkClient, ruleID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package rules
@@ -0,0 +1,88 @@
+package rules
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// Rule represents a metering label rule.
+type Rule struct {
+	ID                  string    `json:"id"`
+	MeteringLabelID     string    `json:"metering_label_id"`
+	Direction           Direction `json:"direction"`
+	Excluded            bool      `json:"excluded"`
+	RemoteIPPrefix      string    `json:"remote_ip_prefix"`
+	SourceIPPrefix      string    `json:"source_ip_prefix"`
+	DestinationIPPrefix string    `json:"destination_ip_prefix"`
+	TenantID            string    `json:"tenant_id"`
+	ProjectID           string    `json:"project_id"`
+}
+
+// RulePage is the page returned by a pager when traversing over a
+// collection of metering label rules.
+type RulePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of metering label rules
+// has reached the end of a page and the pager seeks to traverse over a new
+// one. In order to do this, it needs to construct the next page's URL.
+func (r RulePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"metering_label_rules_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a RulePage struct is empty.
+func (r RulePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractRules(r)
+	return len(is) == 0, err
+}
+
+// ExtractRules accepts a Page struct, specifically a RulePage struct, and
+// extracts the elements into a slice of Rule structs.
+func ExtractRules(r pagination.Page) ([]Rule, error) {
+	var s struct {
+		Rules []Rule `json:"metering_label_rules"`
+	}
+	err := (r.(RulePage)).ExtractInto(&s)
+	return s.Rules, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a metering label
+// rule.
+func (r commonResult) Extract() (*Rule, error) {
+	var s struct {
+		Rule *Rule `json:"metering_label_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Rule, err
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,2 @@
+// Package testing includes metering label rule unit tests
+package testing
@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/metering/rules"
+)
+
+const ListResponse = `
+{
+    "metering_label_rules": [
+        {
+            "id": "9536641a-7d14-4dc5-afaf-93a973ce0eb8",
+            "metering_label_id": "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+            "direction": "egress",
+            "excluded": false,
+            "remote_ip_prefix": null,
+            "source_ip_prefix": null,
+            "destination_ip_prefix": "0.0.0.0/0",
+            "tenant_id": "45345b0ee1ea477fac0f541b2cb79cd4",
+            "project_id": "45345b0ee1ea477fac0f541b2cb79cd4"
+        },
+        {
+            "id": "ffc6fd15-40de-4e7d-b617-34d3f7a93aec",
+            "metering_label_id": "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+            "direction": "egress",
+            "excluded": true,
+            "remote_ip_prefix": null,
+            "source_ip_prefix": null,
+            "destination_ip_prefix": "10.0.0.0/8",
+            "tenant_id": "45345b0ee1ea477fac0f541b2cb79cd4",
+            "project_id": "45345b0ee1ea477fac0f541b2cb79cd4"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "metering_label_rule": {
+        "id": "ffc6fd15-40de-4e7d-b617-34d3f7a93aec",
+        "metering_label_id": "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+        "direction": "egress",
+        "excluded": true,
+        "remote_ip_prefix": null,
+        "source_ip_prefix": null,
+        "destination_ip_prefix": "10.0.0.0/8",
+        "tenant_id": "45345b0ee1ea477fac0f541b2cb79cd4",
+        "project_id": "45345b0ee1ea477fac0f541b2cb79cd4"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "metering_label_rule": {
+        "metering_label_id": "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+        "direction": "egress",
+        "excluded": true,
+        "destination_ip_prefix": "10.0.0.0/8"
+    }
+}
+`
+
+var Rule1 = rules.Rule{
+	ID:                  "9536641a-7d14-4dc5-afaf-93a973ce0eb8",
+	MeteringLabelID:     "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+	Direction:           rules.DirectionEgress,
+	DestinationIPPrefix: "0.0.0.0/0",
+	TenantID:            "45345b0ee1ea477fac0f541b2cb79cd4",
+	ProjectID:           "45345b0ee1ea477fac0f541b2cb79cd4",
+}
+
+var Rule2 = rules.Rule{
+	ID:                  "ffc6fd15-40de-4e7d-b617-34d3f7a93aec",
+	MeteringLabelID:     "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+	Direction:           rules.DirectionEgress,
+	Excluded:            true,
+	DestinationIPPrefix: "10.0.0.0/8",
+	TenantID:            "45345b0ee1ea477fac0f541b2cb79cd4",
+	ProjectID:           "45345b0ee1ea477fac0f541b2cb79cd4",
+}
@@ -0,0 +1,117 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/metering/rules"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/metering/metering-label-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"metering_label_id": "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+			"direction":         "egress",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+
+	listOpts := rules.ListOpts{
+		MeteringLabelID: "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+		Direction:       rules.DirectionEgress,
+	}
+
+	count := 0
+	err := rules.List(fake.ServiceClient(), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := rules.ExtractRules(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []rules.Rule{Rule1, Rule2}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/metering/metering-label-rules/ffc6fd15-40de-4e7d-b617-34d3f7a93aec", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	rule, err := rules.Get(context.TODO(), fake.ServiceClient(), "ffc6fd15-40de-4e7d-b617-34d3f7a93aec").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &Rule2, rule)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/metering/metering-label-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	excluded := true
+	createOpts := rules.CreateOpts{
+		MeteringLabelID:     "e131d186-b02d-4c0b-83d5-0c0725c4f812",
+		Direction:           rules.DirectionEgress,
+		Excluded:            &excluded,
+		DestinationIPPrefix: "10.0.0.0/8",
+	}
+	rule, err := rules.Create(context.TODO(), fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &Rule2, rule)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := rules.Create(context.TODO(), fake.ServiceClient(), rules.CreateOpts{Direction: rules.DirectionIngress})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/metering/metering-label-rules/ffc6fd15-40de-4e7d-b617-34d3f7a93aec", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := rules.Delete(context.TODO(), fake.ServiceClient(), "ffc6fd15-40de-4e7d-b617-34d3f7a93aec").ExtractErr()
+	th.AssertNoErr(t, err)
+}
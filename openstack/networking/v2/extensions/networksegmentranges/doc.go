@@ -0,0 +1,67 @@
+/*
+Package networksegmentranges contains functionality for working with Neutron
+network segment ranges, which are the pools of VLAN, VXLAN, GRE or Geneve IDs
+that tenant networks are allocated from.
+
+Ranges created from the Neutron configuration are reported as Default and
+cannot be deleted. The Available and Used attributes of a range report which
+of its segmentation IDs are still free.
+
+Example to List Network Segment Ranges
+
+	listOpts := networksegmentranges.ListOpts{
+		NetworkType: "vlan",
+	}
+
+	allPages, err := networksegmentranges.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allRanges, err := networksegmentranges.ExtractNetworkSegmentRanges(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, r := range allRanges {
+		fmt.Printf("%s: %d of %d IDs available\n", r.Name, len(r.Available), r.Maximum-r.Minimum+1)
+	}
+
+Example to Create a Network Segment Range
+
+	shared := false
+	createOpts := networksegmentranges.CreateOpts{
+		Name:            "physnet1-project-a",
+		Shared:          &shared,
+		ProjectID:       "7011dc7fccac4efda89dc3b7f0d9b6dc",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "physnet1",
+		Minimum:         100,
+		Maximum:         120,
+	}
+
+	segmentRange, err := networksegmentranges.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Network Segment Range
+
+	maximum := 150
+	updateOpts := networksegmentranges.UpdateOpts{
+		Maximum: &maximum,
+	}
+
+	segmentRange, err := networksegmentranges.Update(context.TODO(), networkClient, rangeID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Network Segment Range
+
+	err := networksegmentranges.Delete(context.TODO(), networkClient, rangeID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package networksegmentranges
@@ -0,0 +1,170 @@
+package networksegmentranges
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToNetworkSegmentRangeListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the network segment range attributes you want to see returned. SortKey
+// allows you to sort by a particular attribute. SortDir sets the direction,
+// and is either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID              string `q:"id"`
+	Name            string `q:"name"`
+	Description     string `q:"description"`
+	Default         *bool  `q:"default"`
+	Shared          *bool  `q:"shared"`
+	ProjectID       string `q:"project_id"`
+	NetworkType     string `q:"network_type"`
+	PhysicalNetwork string `q:"physical_network"`
+	RevisionNumber  *int   `q:"revision_number"`
+	Limit           int    `q:"limit"`
+	Marker          string `q:"marker"`
+	SortKey         string `q:"sort_key"`
+	SortDir         string `q:"sort_dir"`
+	Tags            string `q:"tags"`
+	TagsAny         string `q:"tags-any"`
+	NotTags         string `q:"not-tags"`
+	NotTagsAny      string `q:"not-tags-any"`
+}
+
+// ToNetworkSegmentRangeListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToNetworkSegmentRangeListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// network segment ranges. It accepts a ListOpts struct, which allows you to
+// filter and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToNetworkSegmentRangeListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return NetworkSegmentRangePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific network segment range based on its unique ID. The
+// result includes the available and used segmentation IDs of the range.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, getURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToNetworkSegmentRangeCreateMap() (map[string]any, error)
+}
+
+// CreateOpts represents options used to create a network segment range.
+type CreateOpts struct {
+	// Name is the human-readable name of the range.
+	Name string `json:"name,omitempty"`
+
+	// Description of the range.
+	Description string `json:"description,omitempty"`
+
+	// Shared makes the range available to every project. A range which is not
+	// shared belongs to ProjectID.
+	Shared *bool `json:"shared,omitempty"`
+
+	// ProjectID is the project which owns a range that is not shared.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// NetworkType is the type of network the range applies to, e.g. vlan,
+	// vxlan, gre or geneve.
+	NetworkType string `json:"network_type" required:"true"`
+
+	// PhysicalNetwork is the name of the physical network the range applies
+	// to. It is only used by vlan ranges.
+	PhysicalNetwork string `json:"physical_network,omitempty"`
+
+	// Minimum is the lowest segmentation ID of the range.
+	Minimum int `json:"minimum" required:"true"`
+
+	// Maximum is the highest segmentation ID of the range.
+	Maximum int `json:"maximum" required:"true"`
+}
+
+// ToNetworkSegmentRangeCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToNetworkSegmentRangeCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "network_segment_range")
+}
+
+// Create accepts a CreateOpts struct and creates a new network segment range
+// using the values provided.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToNetworkSegmentRangeCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToNetworkSegmentRangeUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts represents options used to update a network segment range.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Minimum and Maximum resize the range. Segmentation IDs in use must
+	// remain within it.
+	Minimum *int `json:"minimum,omitempty"`
+	Maximum *int `json:"maximum,omitempty"`
+}
+
+// ToNetworkSegmentRangeUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToNetworkSegmentRangeUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "network_segment_range")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing network segment
+// range using the values provided.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToNetworkSegmentRangeUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, updateURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete accepts a unique ID and deletes the network segment range associated
+// with it. Default ranges, which are created from the Neutron configuration,
+// cannot be deleted.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, deleteURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
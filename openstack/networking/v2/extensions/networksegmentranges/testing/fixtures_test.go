@@ -0,0 +1,150 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/networksegmentranges"
+)
+
+const ListResponse = `
+{
+    "network_segment_ranges": [
+        {
+            "id": "a9a5c3ef-5d2e-4c4f-8e3b-3a2f1e7d6b11",
+            "name": "",
+            "description": "",
+            "default": true,
+            "shared": true,
+            "project_id": null,
+            "network_type": "vxlan",
+            "physical_network": null,
+            "minimum": 1,
+            "maximum": 3,
+            "available": [1, 3],
+            "used": {"2": "7011dc7fccac4efda89dc3b7f0d9b6dc"},
+            "revision_number": 0,
+            "tags": [],
+            "created_at": "2024-03-11T09:00:00Z",
+            "updated_at": "2024-03-11T09:00:00Z"
+        },
+        {
+            "id": "7b8c2f4e-0a1d-4b3c-9e5f-6d7a8b9c0d12",
+            "name": "physnet1-project-a",
+            "description": "",
+            "default": false,
+            "shared": false,
+            "project_id": "7011dc7fccac4efda89dc3b7f0d9b6dc",
+            "network_type": "vlan",
+            "physical_network": "physnet1",
+            "minimum": 100,
+            "maximum": 102,
+            "available": [100, 101, 102],
+            "used": {},
+            "revision_number": 1,
+            "tags": ["rack1"],
+            "created_at": "2024-03-12T10:00:00Z",
+            "updated_at": "2024-03-12T10:00:00Z"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "network_segment_range": {
+        "id": "7b8c2f4e-0a1d-4b3c-9e5f-6d7a8b9c0d12",
+        "name": "physnet1-project-a",
+        "description": "",
+        "default": false,
+        "shared": false,
+        "project_id": "7011dc7fccac4efda89dc3b7f0d9b6dc",
+        "network_type": "vlan",
+        "physical_network": "physnet1",
+        "minimum": 100,
+        "maximum": 102,
+        "available": [100, 101, 102],
+        "used": {},
+        "revision_number": 1,
+        "tags": ["rack1"],
+        "created_at": "2024-03-12T10:00:00Z",
+        "updated_at": "2024-03-12T10:00:00Z"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "network_segment_range": {
+        "name": "physnet1-project-a",
+        "shared": false,
+        "project_id": "7011dc7fccac4efda89dc3b7f0d9b6dc",
+        "network_type": "vlan",
+        "physical_network": "physnet1",
+        "minimum": 100,
+        "maximum": 102
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "network_segment_range": {
+        "maximum": 103
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "network_segment_range": {
+        "id": "7b8c2f4e-0a1d-4b3c-9e5f-6d7a8b9c0d12",
+        "name": "physnet1-project-a",
+        "description": "",
+        "default": false,
+        "shared": false,
+        "project_id": "7011dc7fccac4efda89dc3b7f0d9b6dc",
+        "network_type": "vlan",
+        "physical_network": "physnet1",
+        "minimum": 100,
+        "maximum": 103,
+        "available": [100, 101, 102, 103],
+        "used": {},
+        "revision_number": 2,
+        "tags": ["rack1"],
+        "created_at": "2024-03-12T10:00:00Z",
+        "updated_at": "2024-03-12T11:00:00Z"
+    }
+}
+`
+
+var DefaultRange = networksegmentranges.NetworkSegmentRange{
+	ID:          "a9a5c3ef-5d2e-4c4f-8e3b-3a2f1e7d6b11",
+	Default:     true,
+	Shared:      true,
+	NetworkType: "vxlan",
+	Minimum:     1,
+	Maximum:     3,
+	Available:   []int{1, 3},
+	Used:        map[string]string{"2": "7011dc7fccac4efda89dc3b7f0d9b6dc"},
+	Tags:        []string{},
+	CreatedAt:   time.Date(2024, 3, 11, 9, 0, 0, 0, time.UTC),
+	UpdatedAt:   time.Date(2024, 3, 11, 9, 0, 0, 0, time.UTC),
+}
+
+var ProjectRange = networksegmentranges.NetworkSegmentRange{
+	ID:              "7b8c2f4e-0a1d-4b3c-9e5f-6d7a8b9c0d12",
+	Name:            "physnet1-project-a",
+	ProjectID:       "7011dc7fccac4efda89dc3b7f0d9b6dc",
+	NetworkType:     "vlan",
+	PhysicalNetwork: "physnet1",
+	Minimum:         100,
+	Maximum:         102,
+	Available:       []int{100, 101, 102},
+	Used:            map[string]string{},
+	RevisionNumber:  1,
+	Tags:            []string{"rack1"},
+	CreatedAt:       time.Date(2024, 3, 12, 10, 0, 0, 0, time.UTC),
+	UpdatedAt:       time.Date(2024, 3, 12, 10, 0, 0, 0, time.UTC),
+}
+
+var ExpectedRangesSlice = []networksegmentranges.NetworkSegmentRange{DefaultRange, ProjectRange}
@@ -0,0 +1,128 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/networksegmentranges"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network_segment_ranges", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"shared": "false"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+
+	shared := false
+	count := 0
+	err := networksegmentranges.List(fake.ServiceClient(), networksegmentranges.ListOpts{Shared: &shared}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := networksegmentranges.ExtractNetworkSegmentRanges(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedRangesSlice, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network_segment_ranges/7b8c2f4e-0a1d-4b3c-9e5f-6d7a8b9c0d12", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	r, err := networksegmentranges.Get(context.TODO(), fake.ServiceClient(), "7b8c2f4e-0a1d-4b3c-9e5f-6d7a8b9c0d12").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ProjectRange, r)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network_segment_ranges", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	shared := false
+	createOpts := networksegmentranges.CreateOpts{
+		Name:            "physnet1-project-a",
+		Shared:          &shared,
+		ProjectID:       "7011dc7fccac4efda89dc3b7f0d9b6dc",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "physnet1",
+		Minimum:         100,
+		Maximum:         102,
+	}
+	r, err := networksegmentranges.Create(context.TODO(), fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ProjectRange, r)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network_segment_ranges/7b8c2f4e-0a1d-4b3c-9e5f-6d7a8b9c0d12", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	maximum := 103
+	r, err := networksegmentranges.Update(context.TODO(), fake.ServiceClient(), "7b8c2f4e-0a1d-4b3c-9e5f-6d7a8b9c0d12", networksegmentranges.UpdateOpts{Maximum: &maximum}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 103, r.Maximum)
+	th.AssertDeepEquals(t, []int{100, 101, 102, 103}, r.Available)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network_segment_ranges/7b8c2f4e-0a1d-4b3c-9e5f-6d7a8b9c0d12", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := networksegmentranges.Delete(context.TODO(), fake.ServiceClient(), "7b8c2f4e-0a1d-4b3c-9e5f-6d7a8b9c0d12").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -54,5 +54,40 @@ Example to Delete a Security Group
 	if err != nil {
 		panic(err)
 	}
+
+Example to Reconcile the Rules of a Security Group
+
+	groupID := "37d94f8a-d136-465c-ae46-144f0d8ef141"
+
+	spec := groups.RulesSpec{
+		Rules: []rules.CreateOpts{
+			{
+				Direction:      rules.DirIngress,
+				EtherType:      rules.EtherType4,
+				Protocol:       rules.ProtocolTCP,
+				PortRangeMin:   443,
+				PortRangeMax:   443,
+				RemoteIPPrefix: "0.0.0.0/0",
+			},
+			{
+				Direction: rules.DirIngress,
+				EtherType: rules.EtherType6,
+				Protocol:  "58",
+			},
+		},
+	}
+
+	plan, err := groups.PlanRules(context.TODO(), networkClient, groupID, spec)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Print(plan)
+
+	if !plan.Empty() {
+		if _, err := plan.Apply(context.TODO(), networkClient); err != nil {
+			panic(err)
+		}
+	}
 */
 package groups
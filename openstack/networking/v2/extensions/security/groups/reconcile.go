@@ -0,0 +1,367 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+)
+
+// RulesSpec is the desired set of rules of a security group.
+type RulesSpec struct {
+	// Rules are the rules the group should have. The SecGroupID of each rule
+	// is ignored. Protocols may be given by name or number, and a rule without
+	// an EtherType is inferred from its RemoteIPPrefix, defaulting to IPv4.
+	Rules []rules.CreateOpts
+
+	// NoDefaultEgress removes the rules allowing all IPv4 and IPv6 egress
+	// traffic which Neutron adds to new groups, unless Rules contain them. By
+	// default they are kept.
+	NoDefaultEgress bool
+}
+
+// RulesPlan is the set of changes needed to bring the rules of a security
+// group to a RulesSpec.
+type RulesPlan struct {
+	SecGroupID string
+
+	// Add holds the rules to create, normalized.
+	Add []rules.CreateOpts
+
+	// Remove holds the existing rules which are not part of the spec.
+	Remove []rules.SecGroupRule
+
+	// Keep holds the existing rules which match a rule of the spec.
+	Keep []rules.SecGroupRule
+}
+
+// Empty reports whether the group already matches the spec.
+func (p RulesPlan) Empty() bool {
+	return len(p.Add) == 0 && len(p.Remove) == 0
+}
+
+// String formats the plan as a diff, one rule per line, prefixed with "+"
+// for rules to add, "-" for rules to remove and " " for rules to keep.
+func (p RulesPlan) String() string {
+	var b strings.Builder
+	for _, r := range p.Add {
+		fmt.Fprintf(&b, "+ %s\n", keyFromOpts(r))
+	}
+	for _, r := range p.Remove {
+		fmt.Fprintf(&b, "- %s\n", keyFromRule(r))
+	}
+	for _, r := range p.Keep {
+		fmt.Fprintf(&b, "  %s\n", keyFromRule(r))
+	}
+	return b.String()
+}
+
+// PlanRules lists the rules of a security group and compares them with spec.
+func PlanRules(ctx context.Context, client *gophercloud.ServiceClient, id string, spec RulesSpec) (*RulesPlan, error) {
+	allPages, err := rules.List(client, rules.ListOpts{SecGroupID: id}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := rules.ExtractRules(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := DiffRules(id, existing, spec)
+	return &plan, nil
+}
+
+// DiffRules compares the existing rules of a security group with spec. Rules
+// are compared on their direction, ethertype, protocol, port range and
+// remote; descriptions are ignored.
+func DiffRules(id string, existing []rules.SecGroupRule, spec RulesSpec) RulesPlan {
+	plan := RulesPlan{SecGroupID: id}
+
+	desired := make(map[ruleKey]rules.CreateOpts)
+	var order []ruleKey
+	addDesired := func(opts rules.CreateOpts) {
+		opts = normalizeOpts(opts)
+		opts.SecGroupID = id
+		k := keyFromOpts(opts)
+		if _, ok := desired[k]; !ok {
+			desired[k] = opts
+			order = append(order, k)
+		}
+	}
+
+	for _, opts := range spec.Rules {
+		addDesired(opts)
+	}
+	if !spec.NoDefaultEgress {
+		for _, et := range []rules.RuleEtherType{rules.EtherType4, rules.EtherType6} {
+			k := keyFromOpts(normalizeOpts(rules.CreateOpts{Direction: rules.DirEgress, EtherType: et}))
+			if _, ok := desired[k]; !ok {
+				desired[k] = rules.CreateOpts{Direction: rules.DirEgress, EtherType: et, SecGroupID: id}
+				order = append(order, k)
+			}
+		}
+	}
+
+	matched := make(map[ruleKey]bool)
+	for _, r := range existing {
+		k := keyFromRule(r)
+		if _, ok := desired[k]; ok && !matched[k] {
+			matched[k] = true
+			plan.Keep = append(plan.Keep, r)
+			continue
+		}
+		plan.Remove = append(plan.Remove, r)
+	}
+
+	for _, k := range order {
+		if !matched[k] {
+			plan.Add = append(plan.Add, desired[k])
+		}
+	}
+
+	return plan
+}
+
+// Apply creates the rules to add with a single bulk request, then deletes
+// the rules to remove, so that traffic allowed by both the old and the new
+// rules is never interrupted. Rules which are already gone count as deleted.
+// It returns the created rules.
+func (p *RulesPlan) Apply(ctx context.Context, client *gophercloud.ServiceClient) ([]rules.SecGroupRule, error) {
+	var created []rules.SecGroupRule
+	if len(p.Add) > 0 {
+		var err error
+		created, err = rules.CreateBulk(ctx, client, p.Add).Extract()
+		if err != nil {
+			return nil, fmt.Errorf("unable to create rules of security group %s: %w", p.SecGroupID, err)
+		}
+	}
+
+	for _, r := range p.Remove {
+		err := rules.Delete(ctx, client, r.ID).ExtractErr()
+		if err != nil && !gophercloud.ResponseCodeIs(err, 404) {
+			return created, fmt.Errorf("unable to delete rule %s of security group %s: %w", r.ID, p.SecGroupID, err)
+		}
+	}
+
+	return created, nil
+}
+
+// ReconcileRules brings the rules of a security group to spec and returns the
+// plan which was applied.
+func ReconcileRules(ctx context.Context, client *gophercloud.ServiceClient, id string, spec RulesSpec) (*RulesPlan, error) {
+	plan, err := PlanRules(ctx, client, id, spec)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := plan.Apply(ctx, client); err != nil {
+		return plan, err
+	}
+	return plan, nil
+}
+
+// ruleKey is the normalized identity of a rule.
+type ruleKey struct {
+	direction      string
+	etherType      string
+	protocol       string
+	portRangeMin   int
+	portRangeMax   int
+	remoteIPPrefix string
+	remoteGroupID  string
+	remoteAddrGrp  string
+}
+
+func (k ruleKey) String() string {
+	s := k.direction + " " + k.etherType + " "
+	if k.protocol == "" {
+		s += "any"
+	} else {
+		s += k.protocol
+	}
+
+	switch {
+	case k.portRangeMin == 0 && k.portRangeMax == 0:
+	case k.portRangeMin == k.portRangeMax:
+		s += " " + strconv.Itoa(k.portRangeMin)
+	default:
+		s += fmt.Sprintf(" %d-%d", k.portRangeMin, k.portRangeMax)
+	}
+
+	peer := "from"
+	if k.direction == string(rules.DirEgress) {
+		peer = "to"
+	}
+	switch {
+	case k.remoteGroupID != "":
+		s += " " + peer + " group " + k.remoteGroupID
+	case k.remoteAddrGrp != "":
+		s += " " + peer + " address group " + k.remoteAddrGrp
+	case k.remoteIPPrefix != "":
+		s += " " + peer + " " + k.remoteIPPrefix
+	default:
+		s += " " + peer + " any"
+	}
+	return s
+}
+
+func keyFromOpts(opts rules.CreateOpts) ruleKey {
+	return normalizeKey(ruleKey{
+		direction:      string(opts.Direction),
+		etherType:      string(opts.EtherType),
+		protocol:       string(opts.Protocol),
+		portRangeMin:   opts.PortRangeMin,
+		portRangeMax:   opts.PortRangeMax,
+		remoteIPPrefix: opts.RemoteIPPrefix,
+		remoteGroupID:  opts.RemoteGroupID,
+		remoteAddrGrp:  opts.RemoteAddressGroupID,
+	})
+}
+
+func keyFromRule(r rules.SecGroupRule) ruleKey {
+	return normalizeKey(ruleKey{
+		direction:      r.Direction,
+		etherType:      r.EtherType,
+		protocol:       r.Protocol,
+		portRangeMin:   r.PortRangeMin,
+		portRangeMax:   r.PortRangeMax,
+		remoteIPPrefix: r.RemoteIPPrefix,
+		remoteGroupID:  r.RemoteGroupID,
+		remoteAddrGrp:  r.RemoteAddressGroupID,
+	})
+}
+
+// normalizeOpts rewrites opts in the canonical form used for comparison.
+func normalizeOpts(opts rules.CreateOpts) rules.CreateOpts {
+	k := keyFromOpts(opts)
+	opts.Direction = rules.RuleDirection(k.direction)
+	opts.EtherType = rules.RuleEtherType(k.etherType)
+	opts.Protocol = rules.RuleProtocol(k.protocol)
+	opts.PortRangeMin = k.portRangeMin
+	opts.PortRangeMax = k.portRangeMax
+	opts.RemoteIPPrefix = k.remoteIPPrefix
+	return opts
+}
+
+// protocolNames maps IANA protocol numbers to the names Neutron accepts.
+var protocolNames = map[int]rules.RuleProtocol{
+	1:   rules.ProtocolICMP,
+	2:   rules.ProtocolIGMP,
+	4:   rules.ProtocolIPIP,
+	6:   rules.ProtocolTCP,
+	8:   rules.ProtocolEGP,
+	17:  rules.ProtocolUDP,
+	33:  rules.ProtocolDCCP,
+	41:  rules.ProtocolIPv6Encap,
+	43:  rules.ProtocolIPv6Route,
+	44:  rules.ProtocolIPv6Frag,
+	46:  rules.ProtocolRSVP,
+	47:  rules.ProtocolGRE,
+	50:  rules.ProtocolESP,
+	51:  rules.ProtocolAH,
+	58:  rules.ProtocolIPv6ICMP,
+	59:  rules.ProtocolIPv6NoNxt,
+	60:  rules.ProtocolIPv6Opts,
+	89:  rules.ProtocolOSPF,
+	112: rules.ProtocolVRRP,
+	113: rules.ProtocolPGM,
+	132: rules.ProtocolSCTP,
+	136: rules.ProtocolUDPLite,
+}
+
+// portProtocols are the protocols whose rules match a port range.
+var portProtocols = map[rules.RuleProtocol]bool{
+	rules.ProtocolTCP:     true,
+	rules.ProtocolUDP:     true,
+	rules.ProtocolSCTP:    true,
+	rules.ProtocolDCCP:    true,
+	rules.ProtocolUDPLite: true,
+}
+
+// icmpProtocols are the protocols whose rules match an ICMP type and code.
+var icmpProtocols = map[rules.RuleProtocol]bool{
+	rules.ProtocolICMP:     true,
+	rules.ProtocolIPv6ICMP: true,
+}
+
+func normalizeKey(k ruleKey) ruleKey {
+	k.direction = strings.ToLower(k.direction)
+	k.remoteIPPrefix = normalizePrefix(k.remoteIPPrefix)
+
+	switch strings.ToLower(k.etherType) {
+	case "ipv6":
+		k.etherType = string(rules.EtherType6)
+	case "ipv4":
+		k.etherType = string(rules.EtherType4)
+	default:
+		k.etherType = string(rules.EtherType4)
+		if strings.Contains(k.remoteIPPrefix, ":") {
+			k.etherType = string(rules.EtherType6)
+		}
+	}
+
+	// A prefix matching every address is the same as no prefix.
+	if k.remoteIPPrefix == "0.0.0.0/0" || k.remoteIPPrefix == "::/0" {
+		k.remoteIPPrefix = ""
+	}
+
+	protocol := rules.RuleProtocol(strings.ToLower(k.protocol))
+	if n, err := strconv.Atoi(string(protocol)); err == nil {
+		if name, ok := protocolNames[n]; ok {
+			protocol = name
+		}
+	}
+	switch protocol {
+	case "any":
+		protocol = rules.ProtocolAny
+	case "icmpv6":
+		protocol = rules.ProtocolIPv6ICMP
+	}
+	if protocol == rules.ProtocolICMP && k.etherType == string(rules.EtherType6) {
+		protocol = rules.ProtocolIPv6ICMP
+	}
+	k.protocol = string(protocol)
+
+	switch {
+	case portProtocols[protocol]:
+		if k.portRangeMin != 0 && k.portRangeMax == 0 {
+			k.portRangeMax = k.portRangeMin
+		}
+		// The full port range is the same as no port range.
+		if k.portRangeMin <= 1 && k.portRangeMax == 65535 {
+			k.portRangeMin, k.portRangeMax = 0, 0
+		}
+	case icmpProtocols[protocol]:
+	default:
+		k.portRangeMin, k.portRangeMax = 0, 0
+	}
+
+	return k
+}
+
+// normalizePrefix returns the canonical form of a CIDR, turning a bare
+// address into a host prefix. Prefixes which cannot be parsed are returned
+// as is.
+func normalizePrefix(prefix string) string {
+	if prefix == "" {
+		return ""
+	}
+	if !strings.Contains(prefix, "/") {
+		ip := net.ParseIP(prefix)
+		if ip == nil {
+			return prefix
+		}
+		if ip.To4() != nil {
+			return ip.String() + "/32"
+		}
+		return ip.String() + "/128"
+	}
+	_, ipnet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return prefix
+	}
+	return ipnet.String()
+}
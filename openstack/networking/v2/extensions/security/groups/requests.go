@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
@@ -140,3 +141,15 @@ func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r Del
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// IDFromName is a convenience function that returns a security group's ID given
+// its name. The lookup is filtered by name server-side and matched exactly
+// client-side.
+//
+// It returns a gophercloud.ErrResourceNotFound if no security group has that
+// name and a gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	return utils.ResolveID(ctx, List(client, ListOpts{Name: name}), ExtractGroups, func(g SecGroup) (string, string) {
+		return g.Name, g.ID
+	}, "security group", name)
+}
@@ -0,0 +1,142 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+const reconcileGroupID = "85cc3048-abc3-43cc-89b3-377341426ac5"
+
+var existingRules = []rules.SecGroupRule{
+	// Default egress rules, as created by Neutron.
+	{ID: "egress-v4", Direction: "egress", EtherType: "IPv4", SecGroupID: reconcileGroupID},
+	{ID: "egress-v6", Direction: "egress", EtherType: "IPv6", SecGroupID: reconcileGroupID},
+	// Matches the desired HTTPS rule given with a protocol number and ::/0.
+	{ID: "https-v6", Direction: "ingress", EtherType: "IPv6", Protocol: "tcp", PortRangeMin: 443, PortRangeMax: 443, SecGroupID: reconcileGroupID},
+	// Matches the desired ICMPv6 rule given as "icmp".
+	{ID: "icmp-v6", Direction: "ingress", EtherType: "IPv6", Protocol: "ipv6-icmp", SecGroupID: reconcileGroupID},
+	// Not desired.
+	{ID: "ssh", Direction: "ingress", EtherType: "IPv4", Protocol: "tcp", PortRangeMin: 22, PortRangeMax: 22, RemoteIPPrefix: "0.0.0.0/0", SecGroupID: reconcileGroupID},
+}
+
+var desiredSpec = groups.RulesSpec{
+	Rules: []rules.CreateOpts{
+		{Direction: rules.DirIngress, EtherType: rules.EtherType6, Protocol: "6", PortRangeMin: 443, PortRangeMax: 443, RemoteIPPrefix: "::/0"},
+		{Direction: rules.DirIngress, EtherType: rules.EtherType6, Protocol: rules.ProtocolICMP},
+		{Direction: rules.DirIngress, Protocol: "TCP", PortRangeMin: 443, RemoteIPPrefix: "192.0.2.10"},
+		// Duplicate of the previous rule once normalized.
+		{Direction: rules.DirIngress, EtherType: rules.EtherType4, Protocol: rules.ProtocolTCP, PortRangeMin: 443, PortRangeMax: 443, RemoteIPPrefix: "192.0.2.10/32"},
+		// Full port range is the same as any port.
+		{Direction: rules.DirIngress, EtherType: rules.EtherType4, Protocol: rules.ProtocolUDP, PortRangeMin: 1, PortRangeMax: 65535, RemoteGroupID: reconcileGroupID},
+	},
+}
+
+func TestDiffRules(t *testing.T) {
+	plan := groups.DiffRules(reconcileGroupID, existingRules, desiredSpec)
+
+	expectedAdd := []rules.CreateOpts{
+		{Direction: rules.DirIngress, EtherType: rules.EtherType4, SecGroupID: reconcileGroupID, Protocol: rules.ProtocolTCP, PortRangeMin: 443, PortRangeMax: 443, RemoteIPPrefix: "192.0.2.10/32"},
+		{Direction: rules.DirIngress, EtherType: rules.EtherType4, SecGroupID: reconcileGroupID, Protocol: rules.ProtocolUDP, RemoteGroupID: reconcileGroupID},
+	}
+	th.AssertDeepEquals(t, expectedAdd, plan.Add)
+
+	var keep, remove []string
+	for _, r := range plan.Keep {
+		keep = append(keep, r.ID)
+	}
+	for _, r := range plan.Remove {
+		remove = append(remove, r.ID)
+	}
+	th.AssertDeepEquals(t, []string{"egress-v4", "egress-v6", "https-v6", "icmp-v6"}, keep)
+	th.AssertDeepEquals(t, []string{"ssh"}, remove)
+	th.AssertEquals(t, false, plan.Empty())
+
+	expectedDiff := `+ ingress IPv4 tcp 443 from 192.0.2.10/32
++ ingress IPv4 udp from group 85cc3048-abc3-43cc-89b3-377341426ac5
+- ingress IPv4 tcp 22 from any
+  egress IPv4 any to any
+  egress IPv6 any to any
+  ingress IPv6 tcp 443 from any
+  ingress IPv6 ipv6-icmp from any
+`
+	th.AssertEquals(t, expectedDiff, plan.String())
+}
+
+func TestDiffRulesNoDefaultEgress(t *testing.T) {
+	spec := groups.RulesSpec{NoDefaultEgress: true}
+	plan := groups.DiffRules(reconcileGroupID, existingRules[:2], spec)
+	th.AssertEquals(t, 0, len(plan.Add))
+	th.AssertEquals(t, 0, len(plan.Keep))
+	th.AssertEquals(t, 2, len(plan.Remove))
+
+	// Default egress rules are recreated when missing.
+	plan = groups.DiffRules(reconcileGroupID, nil, groups.RulesSpec{})
+	th.AssertDeepEquals(t, []rules.CreateOpts{
+		{Direction: rules.DirEgress, EtherType: rules.EtherType4, SecGroupID: reconcileGroupID},
+		{Direction: rules.DirEgress, EtherType: rules.EtherType6, SecGroupID: reconcileGroupID},
+	}, plan.Add)
+}
+
+func TestReconcileRules(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/security-group-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"security_group_id": reconcileGroupID})
+			fmt.Fprintf(w, `
+{
+    "security_group_rules": [
+        {"id": "egress-v4", "direction": "egress", "ethertype": "IPv4", "protocol": null, "remote_ip_prefix": null, "security_group_id": "%[1]s"},
+        {"id": "egress-v6", "direction": "egress", "ethertype": "IPv6", "protocol": null, "remote_ip_prefix": null, "security_group_id": "%[1]s"},
+        {"id": "ssh", "direction": "ingress", "ethertype": "IPv4", "protocol": "6", "port_range_min": 22, "port_range_max": 22, "remote_ip_prefix": "0.0.0.0/0", "security_group_id": "%[1]s"}
+    ]
+}`, reconcileGroupID)
+		case "POST":
+			th.TestJSONRequest(t, r, fmt.Sprintf(`
+{
+    "security_group_rules": [
+        {"direction": "ingress", "ethertype": "IPv4", "protocol": "tcp", "port_range_min": 443, "port_range_max": 443, "security_group_id": "%s"}
+    ]
+}`, reconcileGroupID))
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `
+{
+    "security_group_rules": [
+        {"id": "https", "direction": "ingress", "ethertype": "IPv4", "protocol": "tcp", "port_range_min": 443, "port_range_max": 443, "security_group_id": "%s"}
+    ]
+}`, reconcileGroupID)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	var deleted []string
+	th.Mux.HandleFunc("/v2.0/security-group-rules/ssh", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		deleted = append(deleted, "ssh")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	spec := groups.RulesSpec{
+		Rules: []rules.CreateOpts{
+			{Direction: rules.DirIngress, EtherType: rules.EtherType4, Protocol: rules.ProtocolTCP, PortRangeMin: 443, PortRangeMax: 443, RemoteIPPrefix: "0.0.0.0/0"},
+		},
+	}
+	plan, err := groups.ReconcileRules(context.TODO(), fake.ServiceClient(), reconcileGroupID, spec)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(plan.Add))
+	th.AssertEquals(t, 2, len(plan.Keep))
+	th.AssertDeepEquals(t, []string{"ssh"}, deleted)
+}
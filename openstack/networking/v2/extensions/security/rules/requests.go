@@ -13,22 +13,23 @@ import (
 // you to sort by a particular network attribute. SortDir sets the direction,
 // and is either `asc' or `desc'. Marker and Limit are used for pagination.
 type ListOpts struct {
-	Direction      string `q:"direction"`
-	EtherType      string `q:"ethertype"`
-	ID             string `q:"id"`
-	Description    string `q:"description"`
-	PortRangeMax   int    `q:"port_range_max"`
-	PortRangeMin   int    `q:"port_range_min"`
-	Protocol       string `q:"protocol"`
-	RemoteGroupID  string `q:"remote_group_id"`
-	RemoteIPPrefix string `q:"remote_ip_prefix"`
-	SecGroupID     string `q:"security_group_id"`
-	TenantID       string `q:"tenant_id"`
-	ProjectID      string `q:"project_id"`
-	Limit          int    `q:"limit"`
-	Marker         string `q:"marker"`
-	SortKey        string `q:"sort_key"`
-	SortDir        string `q:"sort_dir"`
+	Direction            string `q:"direction"`
+	EtherType            string `q:"ethertype"`
+	ID                   string `q:"id"`
+	Description          string `q:"description"`
+	PortRangeMax         int    `q:"port_range_max"`
+	PortRangeMin         int    `q:"port_range_min"`
+	Protocol             string `q:"protocol"`
+	RemoteGroupID        string `q:"remote_group_id"`
+	RemoteIPPrefix       string `q:"remote_ip_prefix"`
+	RemoteAddressGroupID string `q:"remote_address_group_id"`
+	SecGroupID           string `q:"security_group_id"`
+	TenantID             string `q:"tenant_id"`
+	ProjectID            string `q:"project_id"`
+	Limit                int    `q:"limit"`
+	Marker               string `q:"marker"`
+	SortKey              string `q:"sort_key"`
+	SortDir              string `q:"sort_dir"`
 }
 
 // List returns a Pager which allows you to iterate over a collection of
@@ -119,14 +120,20 @@ type CreateOpts struct {
 	Protocol RuleProtocol `json:"protocol,omitempty"`
 
 	// The remote group ID to be associated with this security group rule. You can
-	// specify either RemoteGroupID or RemoteIPPrefix.
+	// specify one of RemoteGroupID, RemoteIPPrefix or RemoteAddressGroupID.
 	RemoteGroupID string `json:"remote_group_id,omitempty"`
 
 	// The remote IP prefix to be associated with this security group rule. You can
-	// specify either RemoteGroupID or RemoteIPPrefix. This attribute matches the
-	// specified IP prefix as the source IP address of the IP packet.
+	// specify one of RemoteGroupID, RemoteIPPrefix or RemoteAddressGroupID. This
+	// attribute matches the specified IP prefix as the source IP address of the IP
+	// packet.
 	RemoteIPPrefix string `json:"remote_ip_prefix,omitempty"`
 
+	// The remote address group ID to be associated with this security group rule.
+	// The rule matches every address of the group, see extensions/addressgroups.
+	// You can specify one of RemoteGroupID, RemoteIPPrefix or RemoteAddressGroupID.
+	RemoteAddressGroupID string `json:"remote_address_group_id,omitempty"`
+
 	// TenantID is the UUID of the project who owns the Rule.
 	// Only administrative users can specify a project UUID other than their own.
 	ProjectID string `json:"project_id,omitempty"`
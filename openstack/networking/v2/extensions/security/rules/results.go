@@ -51,6 +51,9 @@ type SecGroupRule struct {
 	// matches the specified IP prefix as the source IP address of the IP packet.
 	RemoteIPPrefix string `json:"remote_ip_prefix"`
 
+	// The remote address group ID associated with this security group rule.
+	RemoteAddressGroupID string `json:"remote_address_group_id"`
+
 	// TenantID is the project owner of this security group rule.
 	TenantID string `json:"tenant_id"`
 
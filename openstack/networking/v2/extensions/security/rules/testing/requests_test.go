@@ -393,3 +393,82 @@ func TestDelete(t *testing.T) {
 	res := rules.Delete(context.TODO(), fake.ServiceClient(), "4ec89087-d057-4e2c-911f-60a3b47ee304")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestCreateRemoteAddressGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/security-group-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "security_group_rule": {
+        "direction": "ingress",
+        "ethertype": "IPv4",
+        "port_range_min": 22,
+        "port_range_max": 22,
+        "protocol": "tcp",
+        "remote_address_group_id": "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f",
+        "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"
+    }
+}
+      `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, `
+{
+    "security_group_rule": {
+        "direction": "ingress",
+        "ethertype": "IPv4",
+        "id": "6d0a2a0a-6f3c-4a53-9a55-3b1c5c4d1e77",
+        "port_range_max": 22,
+        "port_range_min": 22,
+        "protocol": "tcp",
+        "remote_group_id": null,
+        "remote_ip_prefix": null,
+        "remote_address_group_id": "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f",
+        "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a",
+        "tenant_id": "e4f50856753b4dc6afee5fa6b9b6c550"
+    }
+}
+    `)
+	})
+
+	opts := rules.CreateOpts{
+		Direction:            rules.DirIngress,
+		EtherType:            rules.EtherType4,
+		PortRangeMin:         22,
+		PortRangeMax:         22,
+		Protocol:             rules.ProtocolTCP,
+		RemoteAddressGroupID: "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f",
+		SecGroupID:           "a7734e61-b545-452d-a3cd-0189cbd9747a",
+	}
+	rule, err := rules.Create(context.TODO(), fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f", rule.RemoteAddressGroupID)
+}
+
+func TestListRemoteAddressGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/security-group-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"remote_address_group_id": "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `{"security_group_rules": []}`)
+	})
+
+	allPages, err := rules.List(fake.ServiceClient(), rules.ListOpts{RemoteAddressGroupID: "9ba1e1e4-1c0c-4d6c-8bd3-3e2cf26e0d2f"}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := rules.ExtractRules(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(actual))
+}
@@ -0,0 +1,79 @@
+/*
+Package segments contains functionality for working with Neutron segments,
+the layer 2 building blocks of routed provider networks.
+
+A routed provider network is made of several segments, each mapped to a
+physical network. Subnets are associated with a segment by setting
+subnets.CreateOpts.SegmentID, and can be listed by segment with
+subnets.ListOpts.SegmentID.
+
+Example to List Segments of a Network
+
+	listOpts := segments.ListOpts{
+		NetworkID: "6227a5d5-8f9b-4a2d-8ac6-9a1d4fcc3e6a",
+	}
+
+	allPages, err := segments.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allSegments, err := segments.ExtractSegments(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, segment := range allSegments {
+		fmt.Printf("%+v\n", segment)
+	}
+
+Example to Create a Segment
+
+	segmentationID := 2016
+	createOpts := segments.CreateOpts{
+		NetworkID:       "6227a5d5-8f9b-4a2d-8ac6-9a1d4fcc3e6a",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "rack2",
+		SegmentationID:  &segmentationID,
+		Name:            "rack2",
+	}
+
+	segment, err := segments.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Associate a Subnet with a Segment
+
+	createOpts := subnets.CreateOpts{
+		NetworkID: "6227a5d5-8f9b-4a2d-8ac6-9a1d4fcc3e6a",
+		SegmentID: segment.ID,
+		CIDR:      "10.2.0.0/24",
+		IPVersion: gophercloud.IPv4,
+	}
+
+	subnet, err := subnets.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Segment
+
+	name := "rack2-vlan"
+	updateOpts := segments.UpdateOpts{
+		Name: &name,
+	}
+
+	segment, err := segments.Update(context.TODO(), networkClient, segmentID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Segment
+
+	err := segments.Delete(context.TODO(), networkClient, segmentID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package segments
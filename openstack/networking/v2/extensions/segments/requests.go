@@ -0,0 +1,153 @@
+package segments
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToSegmentListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the segment attributes you want to see returned. SortKey allows you to sort
+// by a particular segment attribute. SortDir sets the direction, and is either
+// `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID              string `q:"id"`
+	NetworkID       string `q:"network_id"`
+	Name            string `q:"name"`
+	Description     string `q:"description"`
+	NetworkType     string `q:"network_type"`
+	PhysicalNetwork string `q:"physical_network"`
+	SegmentationID  *int   `q:"segmentation_id"`
+	RevisionNumber  *int   `q:"revision_number"`
+	Limit           int    `q:"limit"`
+	Marker          string `q:"marker"`
+	SortKey         string `q:"sort_key"`
+	SortDir         string `q:"sort_dir"`
+}
+
+// ToSegmentListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToSegmentListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// segments. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToSegmentListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return SegmentPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific segment based on its unique ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, getURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToSegmentCreateMap() (map[string]any, error)
+}
+
+// CreateOpts represents options used to create a segment.
+type CreateOpts struct {
+	// NetworkID is the ID of the network the segment belongs to.
+	NetworkID string `json:"network_id" required:"true"`
+
+	// NetworkType is the type of physical network that the segment maps to,
+	// e.g. vlan, vxlan, geneve or flat.
+	NetworkType string `json:"network_type" required:"true"`
+
+	// PhysicalNetwork is the name of the physical network the segment is
+	// implemented on.
+	PhysicalNetwork string `json:"physical_network,omitempty"`
+
+	// SegmentationID is the ID of the segment on the physical network, e.g.
+	// the VLAN ID. Neutron allocates one when it is not set.
+	SegmentationID *int `json:"segmentation_id,omitempty"`
+
+	// Name is the human-readable name of the segment.
+	Name string `json:"name,omitempty"`
+
+	// Description of the segment.
+	Description string `json:"description,omitempty"`
+}
+
+// ToSegmentCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToSegmentCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "segment")
+}
+
+// Create accepts a CreateOpts struct and creates a new segment using the
+// values provided.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToSegmentCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToSegmentUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts represents options used to update a segment. Only the name and
+// description of a segment can be changed.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToSegmentUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToSegmentUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "segment")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing segment using
+// the values provided.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToSegmentUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, updateURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete accepts a unique ID and deletes the segment associated with it. A
+// segment can only be deleted once no subnet is associated with it.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, deleteURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,120 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/segments"
+)
+
+const ListResponse = `
+{
+    "segments": [
+        {
+            "id": "053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52",
+            "network_id": "6227a5d5-8f9b-4a2d-8ac6-9a1d4fcc3e6a",
+            "name": "rack1",
+            "description": "",
+            "network_type": "vlan",
+            "physical_network": "rack1",
+            "segmentation_id": 2016,
+            "revision_number": 1,
+            "created_at": "2024-03-11T09:14:26Z",
+            "updated_at": "2024-03-11T09:14:26Z"
+        },
+        {
+            "id": "b7e6a1fe-1f3e-4f8a-bb6c-5a0f6b1b9b4e",
+            "network_id": "6227a5d5-8f9b-4a2d-8ac6-9a1d4fcc3e6a",
+            "name": "rack2",
+            "description": "second rack",
+            "network_type": "vlan",
+            "physical_network": "rack2",
+            "segmentation_id": 2017,
+            "revision_number": 3,
+            "created_at": "2024-03-11T09:15:02Z",
+            "updated_at": "2024-03-12T10:01:44Z"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "segment": {
+        "id": "053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52",
+        "network_id": "6227a5d5-8f9b-4a2d-8ac6-9a1d4fcc3e6a",
+        "name": "rack1",
+        "description": "",
+        "network_type": "vlan",
+        "physical_network": "rack1",
+        "segmentation_id": 2016,
+        "revision_number": 1,
+        "created_at": "2024-03-11T09:14:26Z",
+        "updated_at": "2024-03-11T09:14:26Z"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "segment": {
+        "network_id": "6227a5d5-8f9b-4a2d-8ac6-9a1d4fcc3e6a",
+        "network_type": "vlan",
+        "physical_network": "rack1",
+        "segmentation_id": 2016,
+        "name": "rack1"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "segment": {
+        "name": "rack1-vlan",
+        "description": "first rack"
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "segment": {
+        "id": "053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52",
+        "network_id": "6227a5d5-8f9b-4a2d-8ac6-9a1d4fcc3e6a",
+        "name": "rack1-vlan",
+        "description": "first rack",
+        "network_type": "vlan",
+        "physical_network": "rack1",
+        "segmentation_id": 2016,
+        "revision_number": 2,
+        "created_at": "2024-03-11T09:14:26Z",
+        "updated_at": "2024-03-12T08:00:00Z"
+    }
+}
+`
+
+var Segment1 = segments.Segment{
+	ID:              "053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52",
+	NetworkID:       "6227a5d5-8f9b-4a2d-8ac6-9a1d4fcc3e6a",
+	Name:            "rack1",
+	NetworkType:     "vlan",
+	PhysicalNetwork: "rack1",
+	SegmentationID:  2016,
+	RevisionNumber:  1,
+	CreatedAt:       time.Date(2024, 3, 11, 9, 14, 26, 0, time.UTC),
+	UpdatedAt:       time.Date(2024, 3, 11, 9, 14, 26, 0, time.UTC),
+}
+
+var Segment2 = segments.Segment{
+	ID:              "b7e6a1fe-1f3e-4f8a-bb6c-5a0f6b1b9b4e",
+	NetworkID:       "6227a5d5-8f9b-4a2d-8ac6-9a1d4fcc3e6a",
+	Name:            "rack2",
+	Description:     "second rack",
+	NetworkType:     "vlan",
+	PhysicalNetwork: "rack2",
+	SegmentationID:  2017,
+	RevisionNumber:  3,
+	CreatedAt:       time.Date(2024, 3, 11, 9, 15, 2, 0, time.UTC),
+	UpdatedAt:       time.Date(2024, 3, 12, 10, 1, 44, 0, time.UTC),
+}
+
+var ExpectedSegmentsSlice = []segments.Segment{Segment1, Segment2}
@@ -0,0 +1,147 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/segments"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"network_id":      "6227a5d5-8f9b-4a2d-8ac6-9a1d4fcc3e6a",
+			"segmentation_id": "0",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+
+	segmentationID := 0
+	listOpts := segments.ListOpts{
+		NetworkID:      "6227a5d5-8f9b-4a2d-8ac6-9a1d4fcc3e6a",
+		SegmentationID: &segmentationID,
+	}
+
+	count := 0
+	err := segments.List(fake.ServiceClient(), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := segments.ExtractSegments(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, ExpectedSegmentsSlice, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments/053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	s, err := segments.Get(context.TODO(), fake.ServiceClient(), "053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &Segment1, s)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	segmentationID := 2016
+	createOpts := segments.CreateOpts{
+		NetworkID:       "6227a5d5-8f9b-4a2d-8ac6-9a1d4fcc3e6a",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "rack1",
+		SegmentationID:  &segmentationID,
+		Name:            "rack1",
+	}
+	s, err := segments.Create(context.TODO(), fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &Segment1, s)
+}
+
+func TestCreateRequiresNetwork(t *testing.T) {
+	res := segments.Create(context.TODO(), fake.ServiceClient(), segments.CreateOpts{NetworkType: "vlan"})
+	if res.Err == nil {
+		t.Fatal("expected error for missing network_id")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments/053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	name := "rack1-vlan"
+	description := "first rack"
+	updateOpts := segments.UpdateOpts{
+		Name:        &name,
+		Description: &description,
+	}
+	s, err := segments.Update(context.TODO(), fake.ServiceClient(), "053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "rack1-vlan", s.Name)
+	th.AssertEquals(t, "first rack", s.Description)
+	th.AssertEquals(t, 2, s.RevisionNumber)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments/053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := segments.Delete(context.TODO(), fake.ServiceClient(), "053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52").ExtractErr()
+	th.AssertNoErr(t, err)
+}
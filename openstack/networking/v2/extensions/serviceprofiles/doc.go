@@ -0,0 +1,56 @@
+/*
+Package serviceprofiles contains functionality for working with Neutron
+service profiles.
+
+A service profile names the driver, and its settings, which implements a
+Neutron flavor. Service profiles are associated with flavors with
+flavors.AssociateServiceProfile.
+
+Example to List Service Profiles
+
+	allPages, err := serviceprofiles.List(networkClient, serviceprofiles.ListOpts{}).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allProfiles, err := serviceprofiles.ExtractServiceProfiles(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, profile := range allProfiles {
+		fmt.Printf("%+v\n", profile)
+	}
+
+Example to Create a Service Profile
+
+	createOpts := serviceprofiles.CreateOpts{
+		Description: "L3 HA driver",
+		Driver:      "neutron.services.l3_router.service_providers.l3_ha.HaDriver",
+	}
+
+	profile, err := serviceprofiles.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Service Profile
+
+	description := "L3 HA driver, deprecated"
+	updateOpts := serviceprofiles.UpdateOpts{
+		Description: &description,
+	}
+
+	profile, err := serviceprofiles.Update(context.TODO(), networkClient, profileID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Service Profile
+
+	err := serviceprofiles.Delete(context.TODO(), networkClient, profileID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package serviceprofiles
@@ -0,0 +1,145 @@
+package serviceprofiles
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToServiceProfileListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the service profile attributes you want to see returned. SortKey allows you
+// to sort by a particular service profile attribute. SortDir sets the
+// direction, and is either `asc' or `desc'. Marker and Limit are used for
+// pagination.
+type ListOpts struct {
+	ID          string `q:"id"`
+	Description string `q:"description"`
+	Driver      string `q:"driver"`
+	Enabled     *bool  `q:"enabled"`
+	Limit       int    `q:"limit"`
+	Marker      string `q:"marker"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToServiceProfileListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToServiceProfileListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// service profiles. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToServiceProfileListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return ServiceProfilePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific service profile based on its unique ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, getURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToServiceProfileCreateMap() (map[string]any, error)
+}
+
+// CreateOpts represents options used to create a service profile. Either
+// Driver or Metainfo must be set.
+type CreateOpts struct {
+	Description string `json:"description,omitempty"`
+
+	// Driver is the Python import path of the service driver, e.g.
+	// "neutron.services.l3_router.service_providers.dvrha.DvrHaDriver".
+	Driver string `json:"driver,omitempty"`
+
+	// Metainfo is a JSON-encoded string of driver-specific settings.
+	Metainfo string `json:"metainfo,omitempty"`
+
+	// Enabled is whether the service profile can be used. It defaults to
+	// true.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ToServiceProfileCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToServiceProfileCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "service_profile")
+}
+
+// Create accepts a CreateOpts struct and creates a new service profile using
+// the values provided. Only administrative users can create service profiles.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToServiceProfileCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToServiceProfileUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts represents options used to update a service profile.
+type UpdateOpts struct {
+	Description *string `json:"description,omitempty"`
+	Driver      *string `json:"driver,omitempty"`
+	Metainfo    *string `json:"metainfo,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// ToServiceProfileUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToServiceProfileUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "service_profile")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing service profile
+// using the values provided.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToServiceProfileUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, updateURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete accepts a unique ID and deletes the service profile associated with
+// it. A service profile cannot be deleted while it is associated with a
+// flavor.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, deleteURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
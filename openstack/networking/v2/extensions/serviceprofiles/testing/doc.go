@@ -0,0 +1,2 @@
+// Package testing includes service profiles unit tests
+package testing
@@ -0,0 +1,82 @@
+package testing
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/serviceprofiles"
+)
+
+const ListResponse = `
+{
+    "service_profiles": [
+        {
+            "id": "7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13",
+            "description": "L3 HA driver",
+            "driver": "neutron.services.l3_router.service_providers.l3_ha.HaDriver",
+            "metainfo": "",
+            "enabled": true
+        },
+        {
+            "id": "a4c2e6f8-0b1d-4f3a-8c5e-7a9b1c3d5e7f",
+            "description": "Single node driver",
+            "driver": "neutron.services.l3_router.service_providers.single_node.SingleNodeDriver",
+            "metainfo": "{\"agent_mode\": \"legacy\"}",
+            "enabled": false
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "service_profile": {
+        "id": "7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13",
+        "description": "L3 HA driver",
+        "driver": "neutron.services.l3_router.service_providers.l3_ha.HaDriver",
+        "metainfo": "",
+        "enabled": true
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "service_profile": {
+        "description": "L3 HA driver",
+        "driver": "neutron.services.l3_router.service_providers.l3_ha.HaDriver"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "service_profile": {
+        "description": "L3 HA driver, deprecated",
+        "enabled": false
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "service_profile": {
+        "id": "7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13",
+        "description": "L3 HA driver, deprecated",
+        "driver": "neutron.services.l3_router.service_providers.l3_ha.HaDriver",
+        "metainfo": "",
+        "enabled": false
+    }
+}
+`
+
+var HAProfile = serviceprofiles.ServiceProfile{
+	ID:          "7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13",
+	Description: "L3 HA driver",
+	Driver:      "neutron.services.l3_router.service_providers.l3_ha.HaDriver",
+	Enabled:     true,
+}
+
+var SingleNodeProfile = serviceprofiles.ServiceProfile{
+	ID:          "a4c2e6f8-0b1d-4f3a-8c5e-7a9b1c3d5e7f",
+	Description: "Single node driver",
+	Driver:      "neutron.services.l3_router.service_providers.single_node.SingleNodeDriver",
+	Metainfo:    `{"agent_mode": "legacy"}`,
+}
@@ -0,0 +1,125 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/serviceprofiles"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/service_profiles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+
+	count := 0
+	err := serviceprofiles.List(fake.ServiceClient(), serviceprofiles.ListOpts{}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := serviceprofiles.ExtractServiceProfiles(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []serviceprofiles.ServiceProfile{HAProfile, SingleNodeProfile}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/service_profiles/7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	profile, err := serviceprofiles.Get(context.TODO(), fake.ServiceClient(), "7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &HAProfile, profile)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/service_profiles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	createOpts := serviceprofiles.CreateOpts{
+		Description: "L3 HA driver",
+		Driver:      "neutron.services.l3_router.service_providers.l3_ha.HaDriver",
+	}
+	profile, err := serviceprofiles.Create(context.TODO(), fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &HAProfile, profile)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/service_profiles/7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	description := "L3 HA driver, deprecated"
+	enabled := false
+	updateOpts := serviceprofiles.UpdateOpts{
+		Description: &description,
+		Enabled:     &enabled,
+	}
+	profile, err := serviceprofiles.Update(context.TODO(), fake.ServiceClient(), "7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, description, profile.Description)
+	th.AssertEquals(t, false, profile.Enabled)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/service_profiles/7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := serviceprofiles.Delete(context.TODO(), fake.ServiceClient(), "7b1d4e8a-3f62-4d5c-9a1e-2b7c8d9e0f13").ExtractErr()
+	th.AssertNoErr(t, err)
+}
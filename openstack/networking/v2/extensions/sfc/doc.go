@@ -0,0 +1,5 @@
+// Package sfc provides information and interaction with the Service Function
+// Chaining (networking-sfc) extension for the OpenStack Networking service,
+// which steers traffic through chains of service functions such as firewalls
+// and intrusion detection appliances.
+package sfc
@@ -0,0 +1,64 @@
+/*
+Package flowclassifiers contains functionality for working with networking-sfc
+flow classifiers.
+
+A flow classifier selects the traffic a port chain applies to, by its layer 2
+to 4 attributes and, optionally, layer 7 parameters.
+
+Example to List Flow Classifiers
+
+	listOpts := flowclassifiers.ListOpts{
+		LogicalSourcePort: "5e4bbe9d-8a1c-4c7e-9b0a-3f2d1c0b9a87",
+	}
+
+	allPages, err := flowclassifiers.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allClassifiers, err := flowclassifiers.ExtractFlowClassifiers(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, classifier := range allClassifiers {
+		fmt.Printf("%+v\n", classifier)
+	}
+
+Example to Create a Flow Classifier
+
+	createOpts := flowclassifiers.CreateOpts{
+		Name:                    "web-traffic",
+		EtherType:               flowclassifiers.EtherType4,
+		Protocol:                flowclassifiers.ProtocolTCP,
+		DestinationPortRangeMin: 80,
+		DestinationPortRangeMax: 80,
+		SourceIPPrefix:          "10.0.0.0/24",
+		LogicalSourcePort:       "5e4bbe9d-8a1c-4c7e-9b0a-3f2d1c0b9a87",
+	}
+
+	classifier, err := flowclassifiers.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Flow Classifier
+
+	description := "HTTP traffic from the web tier"
+	updateOpts := flowclassifiers.UpdateOpts{
+		Description: &description,
+	}
+
+	classifier, err := flowclassifiers.Update(context.TODO(), networkClient, classifierID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Flow Classifier
+
+	err := flowclassifiers.Delete(context.TODO(), networkClient, classifierID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package flowclassifiers
@@ -0,0 +1,182 @@
+package flowclassifiers
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// EtherType values for a flow classifier.
+const (
+	EtherType4 = "IPv4"
+	EtherType6 = "IPv6"
+)
+
+// Protocol values commonly used for a flow classifier.
+const (
+	ProtocolTCP  = "tcp"
+	ProtocolUDP  = "udp"
+	ProtocolICMP = "icmp"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToFlowClassifierListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the flow classifier attributes you want to see returned. SortKey allows you
+// to sort by a particular flow classifier attribute. SortDir sets the
+// direction, and is either `asc' or `desc'. Marker and Limit are used for
+// pagination.
+type ListOpts struct {
+	ID                     string `q:"id"`
+	Name                   string `q:"name"`
+	Description            string `q:"description"`
+	TenantID               string `q:"tenant_id"`
+	ProjectID              string `q:"project_id"`
+	EtherType              string `q:"ethertype"`
+	Protocol               string `q:"protocol"`
+	SourceIPPrefix         string `q:"source_ip_prefix"`
+	DestinationIPPrefix    string `q:"destination_ip_prefix"`
+	LogicalSourcePort      string `q:"logical_source_port"`
+	LogicalDestinationPort string `q:"logical_destination_port"`
+	Limit                  int    `q:"limit"`
+	Marker                 string `q:"marker"`
+	SortKey                string `q:"sort_key"`
+	SortDir                string `q:"sort_dir"`
+}
+
+// ToFlowClassifierListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToFlowClassifierListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of flow
+// classifiers. It accepts a ListOpts struct, which allows you to filter and
+// sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToFlowClassifierListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return FlowClassifierPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific flow classifier based on its unique ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, getURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToFlowClassifierCreateMap() (map[string]any, error)
+}
+
+// CreateOpts represents options used to create a flow classifier.
+type CreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	// EtherType is either EtherType4 or EtherType6. Neutron defaults to
+	// EtherType4.
+	EtherType string `json:"ethertype,omitempty"`
+
+	// Protocol is the IP protocol matched, e.g. ProtocolTCP.
+	Protocol string `json:"protocol,omitempty"`
+
+	SourcePortRangeMin      int `json:"source_port_range_min,omitempty"`
+	SourcePortRangeMax      int `json:"source_port_range_max,omitempty"`
+	DestinationPortRangeMin int `json:"destination_port_range_min,omitempty"`
+	DestinationPortRangeMax int `json:"destination_port_range_max,omitempty"`
+
+	SourceIPPrefix      string `json:"source_ip_prefix,omitempty"`
+	DestinationIPPrefix string `json:"destination_ip_prefix,omitempty"`
+
+	// LogicalSourcePort is the ID of the port the classified traffic comes
+	// from. Most backends, including OVS, require it.
+	LogicalSourcePort string `json:"logical_source_port,omitempty"`
+
+	// LogicalDestinationPort is the ID of the port the classified traffic is
+	// sent to.
+	LogicalDestinationPort string `json:"logical_destination_port,omitempty"`
+
+	// L7Parameters are the layer 7 attributes matched, e.g. "url".
+	L7Parameters map[string]string `json:"l7_parameters,omitempty"`
+
+	// ProjectID is the project which owns the flow classifier. Only
+	// administrative users can specify a project other than their own.
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// ToFlowClassifierCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToFlowClassifierCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "flow_classifier")
+}
+
+// Create accepts a CreateOpts struct and creates a new flow classifier using
+// the values provided.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToFlowClassifierCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToFlowClassifierUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts represents options used to update a flow classifier. The
+// matching attributes of a flow classifier cannot be changed.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToFlowClassifierUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToFlowClassifierUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "flow_classifier")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing flow classifier
+// using the values provided.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToFlowClassifierUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, updateURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete accepts a unique ID and deletes the flow classifier associated with
+// it. A flow classifier cannot be deleted while it belongs to a port chain.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, deleteURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,133 @@
+package flowclassifiers
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a FlowClassifier.
+func (r commonResult) Extract() (*FlowClassifier, error) {
+	var s FlowClassifier
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v any) error {
+	return r.Result.ExtractIntoStructPtr(v, "flow_classifier")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a FlowClassifier.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a FlowClassifier.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a FlowClassifier.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// FlowClassifier represents the traffic a port chain applies to.
+type FlowClassifier struct {
+	// ID is the UUID of the flow classifier.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the flow classifier.
+	Name string `json:"name"`
+
+	// Description of the flow classifier.
+	Description string `json:"description"`
+
+	// EtherType is either EtherType4 or EtherType6.
+	EtherType string `json:"ethertype"`
+
+	// Protocol is the IP protocol matched, if any.
+	Protocol string `json:"protocol"`
+
+	SourcePortRangeMin      int `json:"source_port_range_min"`
+	SourcePortRangeMax      int `json:"source_port_range_max"`
+	DestinationPortRangeMin int `json:"destination_port_range_min"`
+	DestinationPortRangeMax int `json:"destination_port_range_max"`
+
+	SourceIPPrefix      string `json:"source_ip_prefix"`
+	DestinationIPPrefix string `json:"destination_ip_prefix"`
+
+	// LogicalSourcePort is the ID of the port the classified traffic comes
+	// from.
+	LogicalSourcePort string `json:"logical_source_port"`
+
+	// LogicalDestinationPort is the ID of the port the classified traffic is
+	// sent to.
+	LogicalDestinationPort string `json:"logical_destination_port"`
+
+	// L7Parameters are the layer 7 attributes matched.
+	L7Parameters map[string]string `json:"l7_parameters"`
+
+	// TenantID is the project owner of the flow classifier.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the flow classifier.
+	ProjectID string `json:"project_id"`
+}
+
+// FlowClassifierPage is the page returned by a pager when traversing over a
+// collection of flow classifiers.
+type FlowClassifierPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of flow classifiers has
+// reached the end of a page and the pager seeks to traverse over a new one. In
+// order to do this, it needs to construct the next page's URL.
+func (r FlowClassifierPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"flow_classifiers_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a FlowClassifierPage struct is empty.
+func (r FlowClassifierPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractFlowClassifiers(r)
+	return len(is) == 0, err
+}
+
+// ExtractFlowClassifiers accepts a Page struct, specifically a
+// FlowClassifierPage struct, and extracts the elements into a slice of
+// FlowClassifier structs.
+func ExtractFlowClassifiers(r pagination.Page) ([]FlowClassifier, error) {
+	var s []FlowClassifier
+	err := ExtractFlowClassifiersInto(r, &s)
+	return s, err
+}
+
+// ExtractFlowClassifiersInto extracts the elements into a slice of
+// FlowClassifier structs.
+func ExtractFlowClassifiersInto(r pagination.Page, v any) error {
+	return r.(FlowClassifierPage).Result.ExtractIntoSlicePtr(v, "flow_classifiers")
+}
@@ -0,0 +1,2 @@
+// Package testing includes flow classifiers unit tests
+package testing
@@ -0,0 +1,164 @@
+package testing
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/sfc/flowclassifiers"
+)
+
+const ListResponse = `
+{
+    "flow_classifiers": [
+        {
+            "id": "4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+            "name": "web-traffic",
+            "description": "HTTP traffic from the web tier",
+            "ethertype": "IPv4",
+            "protocol": "tcp",
+            "source_port_range_min": null,
+            "source_port_range_max": null,
+            "destination_port_range_min": 80,
+            "destination_port_range_max": 80,
+            "source_ip_prefix": "10.0.0.0/24",
+            "destination_ip_prefix": null,
+            "logical_source_port": "5e4bbe9d-8a1c-4c7e-9b0a-3f2d1c0b9a87",
+            "logical_destination_port": null,
+            "l7_parameters": {},
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5"
+        },
+        {
+            "id": "105a4b0a-73d6-11e5-b392-2c27d72acb4c",
+            "name": "api-calls",
+            "description": "",
+            "ethertype": "IPv6",
+            "protocol": "tcp",
+            "source_port_range_min": 1024,
+            "source_port_range_max": 65535,
+            "destination_port_range_min": 443,
+            "destination_port_range_max": 443,
+            "source_ip_prefix": "2001:db8::/64",
+            "destination_ip_prefix": "2001:db8:1::/64",
+            "logical_source_port": "5e4bbe9d-8a1c-4c7e-9b0a-3f2d1c0b9a87",
+            "logical_destination_port": "c6b7a1d2-3e4f-4a5b-8c9d-0e1f2a3b4c5d",
+            "l7_parameters": {"url": "/api"},
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "flow_classifier": {
+        "id": "4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+        "name": "web-traffic",
+        "description": "HTTP traffic from the web tier",
+        "ethertype": "IPv4",
+        "protocol": "tcp",
+        "source_port_range_min": null,
+        "source_port_range_max": null,
+        "destination_port_range_min": 80,
+        "destination_port_range_max": 80,
+        "source_ip_prefix": "10.0.0.0/24",
+        "destination_ip_prefix": null,
+        "logical_source_port": "5e4bbe9d-8a1c-4c7e-9b0a-3f2d1c0b9a87",
+        "logical_destination_port": null,
+        "l7_parameters": {},
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "flow_classifier": {
+        "name": "web-traffic",
+        "description": "HTTP traffic from the web tier",
+        "ethertype": "IPv4",
+        "protocol": "tcp",
+        "destination_port_range_min": 80,
+        "destination_port_range_max": 80,
+        "source_ip_prefix": "10.0.0.0/24",
+        "logical_source_port": "5e4bbe9d-8a1c-4c7e-9b0a-3f2d1c0b9a87"
+    }
+}
+`
+
+const CreateL7Request = `
+{
+    "flow_classifier": {
+        "name": "api-calls",
+        "ethertype": "IPv6",
+        "protocol": "tcp",
+        "destination_port_range_min": 443,
+        "destination_port_range_max": 443,
+        "logical_source_port": "5e4bbe9d-8a1c-4c7e-9b0a-3f2d1c0b9a87",
+        "l7_parameters": {"url": "/api"}
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "flow_classifier": {
+        "name": "http"
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "flow_classifier": {
+        "id": "4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+        "name": "http",
+        "description": "HTTP traffic from the web tier",
+        "ethertype": "IPv4",
+        "protocol": "tcp",
+        "source_port_range_min": null,
+        "source_port_range_max": null,
+        "destination_port_range_min": 80,
+        "destination_port_range_max": 80,
+        "source_ip_prefix": "10.0.0.0/24",
+        "destination_ip_prefix": null,
+        "logical_source_port": "5e4bbe9d-8a1c-4c7e-9b0a-3f2d1c0b9a87",
+        "logical_destination_port": null,
+        "l7_parameters": {},
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5"
+    }
+}
+`
+
+var WebClassifier = flowclassifiers.FlowClassifier{
+	ID:                      "4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+	Name:                    "web-traffic",
+	Description:             "HTTP traffic from the web tier",
+	EtherType:               flowclassifiers.EtherType4,
+	Protocol:                flowclassifiers.ProtocolTCP,
+	DestinationPortRangeMin: 80,
+	DestinationPortRangeMax: 80,
+	SourceIPPrefix:          "10.0.0.0/24",
+	LogicalSourcePort:       "5e4bbe9d-8a1c-4c7e-9b0a-3f2d1c0b9a87",
+	L7Parameters:            map[string]string{},
+	TenantID:                "d382007aa9904763a801f68ecf065cf5",
+	ProjectID:               "d382007aa9904763a801f68ecf065cf5",
+}
+
+var APIClassifier = flowclassifiers.FlowClassifier{
+	ID:                      "105a4b0a-73d6-11e5-b392-2c27d72acb4c",
+	Name:                    "api-calls",
+	EtherType:               flowclassifiers.EtherType6,
+	Protocol:                flowclassifiers.ProtocolTCP,
+	SourcePortRangeMin:      1024,
+	SourcePortRangeMax:      65535,
+	DestinationPortRangeMin: 443,
+	DestinationPortRangeMax: 443,
+	SourceIPPrefix:          "2001:db8::/64",
+	DestinationIPPrefix:     "2001:db8:1::/64",
+	LogicalSourcePort:       "5e4bbe9d-8a1c-4c7e-9b0a-3f2d1c0b9a87",
+	LogicalDestinationPort:  "c6b7a1d2-3e4f-4a5b-8c9d-0e1f2a3b4c5d",
+	L7Parameters:            map[string]string{"url": "/api"},
+	TenantID:                "d382007aa9904763a801f68ecf065cf5",
+	ProjectID:               "d382007aa9904763a801f68ecf065cf5",
+}
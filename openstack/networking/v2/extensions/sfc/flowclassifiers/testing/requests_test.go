@@ -0,0 +1,163 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/sfc/flowclassifiers"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/flow_classifiers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"logical_source_port": "5e4bbe9d-8a1c-4c7e-9b0a-3f2d1c0b9a87",
+			"protocol":            "tcp",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+
+	listOpts := flowclassifiers.ListOpts{
+		LogicalSourcePort: "5e4bbe9d-8a1c-4c7e-9b0a-3f2d1c0b9a87",
+		Protocol:          flowclassifiers.ProtocolTCP,
+	}
+
+	count := 0
+	err := flowclassifiers.List(fake.ServiceClient(), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := flowclassifiers.ExtractFlowClassifiers(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []flowclassifiers.FlowClassifier{WebClassifier, APIClassifier}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/flow_classifiers/4a334cd4-fe9c-4fae-af4b-321c5e2eb051", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	classifier, err := flowclassifiers.Get(context.TODO(), fake.ServiceClient(), "4a334cd4-fe9c-4fae-af4b-321c5e2eb051").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &WebClassifier, classifier)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/flow_classifiers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	createOpts := flowclassifiers.CreateOpts{
+		Name:                    "web-traffic",
+		Description:             "HTTP traffic from the web tier",
+		EtherType:               flowclassifiers.EtherType4,
+		Protocol:                flowclassifiers.ProtocolTCP,
+		DestinationPortRangeMin: 80,
+		DestinationPortRangeMax: 80,
+		SourceIPPrefix:          "10.0.0.0/24",
+		LogicalSourcePort:       "5e4bbe9d-8a1c-4c7e-9b0a-3f2d1c0b9a87",
+	}
+	classifier, err := flowclassifiers.Create(context.TODO(), fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &WebClassifier, classifier)
+}
+
+func TestCreateL7Parameters(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/flow_classifiers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateL7Request)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, `{"flow_classifier": {"id": "105a4b0a-73d6-11e5-b392-2c27d72acb4c", "l7_parameters": {"url": "/api"}}}`)
+	})
+
+	createOpts := flowclassifiers.CreateOpts{
+		Name:                    "api-calls",
+		EtherType:               flowclassifiers.EtherType6,
+		Protocol:                flowclassifiers.ProtocolTCP,
+		DestinationPortRangeMin: 443,
+		DestinationPortRangeMax: 443,
+		LogicalSourcePort:       "5e4bbe9d-8a1c-4c7e-9b0a-3f2d1c0b9a87",
+		L7Parameters:            map[string]string{"url": "/api"},
+	}
+	classifier, err := flowclassifiers.Create(context.TODO(), fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]string{"url": "/api"}, classifier.L7Parameters)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/flow_classifiers/4a334cd4-fe9c-4fae-af4b-321c5e2eb051", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	name := "http"
+	classifier, err := flowclassifiers.Update(context.TODO(), fake.ServiceClient(), "4a334cd4-fe9c-4fae-af4b-321c5e2eb051", flowclassifiers.UpdateOpts{Name: &name}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "http", classifier.Name)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/flow_classifiers/4a334cd4-fe9c-4fae-af4b-321c5e2eb051", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := flowclassifiers.Delete(context.TODO(), fake.ServiceClient(), "4a334cd4-fe9c-4fae-af4b-321c5e2eb051").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,60 @@
+/*
+Package portchains contains functionality for working with networking-sfc port
+chains.
+
+A port chain steers the traffic matched by its flow classifiers through its
+port pair groups, in order.
+
+Example to List Port Chains
+
+	allPages, err := portchains.List(networkClient, nil).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allChains, err := portchains.ExtractPortChains(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, chain := range allChains {
+		fmt.Printf("%+v\n", chain)
+	}
+
+Example to Create a Port Chain
+
+	createOpts := portchains.CreateOpts{
+		Name:            "inspect-web",
+		PortPairGroups:  []string{firewallGroupID, idsGroupID},
+		FlowClassifiers: []string{classifierID},
+		ChainParameters: &portchains.ChainParameters{
+			Correlation: portchains.CorrelationMPLS,
+			Symmetric:   true,
+		},
+	}
+
+	chain, err := portchains.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update the Flow Classifiers of a Port Chain
+
+	flowClassifiers := []string{classifierID, otherClassifierID}
+	updateOpts := portchains.UpdateOpts{
+		FlowClassifiers: &flowClassifiers,
+	}
+
+	chain, err := portchains.Update(context.TODO(), networkClient, chainID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Port Chain
+
+	err := portchains.Delete(context.TODO(), networkClient, chainID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package portchains
@@ -0,0 +1,162 @@
+package portchains
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// Correlation values for the ChainParameters of a port chain.
+const (
+	CorrelationMPLS = "mpls"
+	CorrelationNSH  = "nsh"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToPortChainListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the port chain attributes you want to see returned. SortKey allows you to
+// sort by a particular port chain attribute. SortDir sets the direction, and is
+// either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	TenantID    string `q:"tenant_id"`
+	ProjectID   string `q:"project_id"`
+	Limit       int    `q:"limit"`
+	Marker      string `q:"marker"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToPortChainListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPortChainListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of port
+// chains. It accepts a ListOpts struct, which allows you to filter and sort the
+// returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToPortChainListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return PortChainPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific port chain based on its unique ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, getURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToPortChainCreateMap() (map[string]any, error)
+}
+
+// CreateOpts represents options used to create a port chain.
+type CreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	// PortPairGroups are the IDs of the port pair groups traffic goes
+	// through, in order.
+	PortPairGroups []string `json:"port_pair_groups" required:"true"`
+
+	// FlowClassifiers are the IDs of the flow classifiers selecting the
+	// traffic steered through the chain.
+	FlowClassifiers []string `json:"flow_classifiers,omitempty"`
+
+	// ChainParameters sets the correlation and symmetry of the chain.
+	ChainParameters *ChainParameters `json:"chain_parameters,omitempty"`
+
+	// ChainID is the numeric ID of the chain, used as the service path ID of
+	// the NSH correlation. Neutron assigns one when it is not set.
+	ChainID int `json:"chain_id,omitempty"`
+
+	// ProjectID is the project which owns the port chain. Only administrative
+	// users can specify a project other than their own.
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// ToPortChainCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToPortChainCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "port_chain")
+}
+
+// Create accepts a CreateOpts struct and creates a new port chain using the
+// values provided.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToPortChainCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToPortChainUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts represents options used to update a port chain.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// PortPairGroups replaces the port pair groups of the chain.
+	PortPairGroups *[]string `json:"port_pair_groups,omitempty"`
+
+	// FlowClassifiers replaces the flow classifiers of the chain.
+	FlowClassifiers *[]string `json:"flow_classifiers,omitempty"`
+}
+
+// ToPortChainUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToPortChainUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "port_chain")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing port chain using
+// the values provided.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToPortChainUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, updateURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete accepts a unique ID and deletes the port chain associated with it.
+// A port chain cannot be deleted while it belongs to a service graph.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, deleteURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
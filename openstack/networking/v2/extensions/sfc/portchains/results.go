@@ -0,0 +1,132 @@
+package portchains
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a PortChain.
+func (r commonResult) Extract() (*PortChain, error) {
+	var s PortChain
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v any) error {
+	return r.Result.ExtractIntoStructPtr(v, "port_chain")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a PortChain.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a PortChain.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a PortChain.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// PortChain represents an ordered list of port pair groups, which the
+// traffic matched by its flow classifiers goes through.
+type PortChain struct {
+	// ID is the UUID of the port chain.
+	ID string `json:"id"`
+
+	// ChainID is the numeric ID of the chain.
+	ChainID int `json:"chain_id"`
+
+	// Name is the human-readable name of the port chain.
+	Name string `json:"name"`
+
+	// Description of the port chain.
+	Description string `json:"description"`
+
+	// PortPairGroups are the IDs of the port pair groups of the chain, in
+	// order.
+	PortPairGroups []string `json:"port_pair_groups"`
+
+	// FlowClassifiers are the IDs of the flow classifiers of the chain.
+	FlowClassifiers []string `json:"flow_classifiers"`
+
+	// ChainParameters are the correlation and symmetry of the chain.
+	ChainParameters ChainParameters `json:"chain_parameters"`
+
+	// TenantID is the project owner of the port chain.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the port chain.
+	ProjectID string `json:"project_id"`
+}
+
+// ChainParameters are the parameters of a port chain.
+type ChainParameters struct {
+	// Correlation is the encapsulation used along the chain, either
+	// CorrelationMPLS or CorrelationNSH.
+	Correlation string `json:"correlation,omitempty"`
+
+	// Symmetric also steers the reverse traffic through the chain, in the
+	// opposite order.
+	Symmetric bool `json:"symmetric,omitempty"`
+}
+
+// PortChainPage is the page returned by a pager when traversing over a
+// collection of port chains.
+type PortChainPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of port chains has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r PortChainPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"port_chains_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a PortChainPage struct is empty.
+func (r PortChainPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractPortChains(r)
+	return len(is) == 0, err
+}
+
+// ExtractPortChains accepts a Page struct, specifically a PortChainPage struct,
+// and extracts the elements into a slice of PortChain structs.
+func ExtractPortChains(r pagination.Page) ([]PortChain, error) {
+	var s []PortChain
+	err := ExtractPortChainsInto(r, &s)
+	return s, err
+}
+
+// ExtractPortChainsInto extracts the elements into a slice of PortChain
+// structs.
+func ExtractPortChainsInto(r pagination.Page, v any) error {
+	return r.(PortChainPage).Result.ExtractIntoSlicePtr(v, "port_chains")
+}
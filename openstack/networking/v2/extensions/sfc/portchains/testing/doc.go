@@ -0,0 +1,2 @@
+// Package testing includes port chains unit tests
+package testing
@@ -0,0 +1,129 @@
+package testing
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/sfc/portchains"
+)
+
+const ListResponse = `
+{
+    "port_chains": [
+        {
+            "id": "1278dcd4-459f-62ed-754b-87fc5e4a6751",
+            "chain_id": 1,
+            "name": "inspect-web",
+            "description": "Steers web traffic through the firewalls",
+            "port_pair_groups": [
+                "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+                "4a634d49-76dc-4fae-af4b-321c5e23d651"
+            ],
+            "flow_classifiers": [
+                "4a334cd4-fe9c-4fae-af4b-321c5e2eb051"
+            ],
+            "chain_parameters": {
+                "correlation": "mpls",
+                "symmetric": true
+            },
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "port_chain": {
+        "id": "1278dcd4-459f-62ed-754b-87fc5e4a6751",
+        "chain_id": 1,
+        "name": "inspect-web",
+        "description": "Steers web traffic through the firewalls",
+        "port_pair_groups": [
+            "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+            "4a634d49-76dc-4fae-af4b-321c5e23d651"
+        ],
+        "flow_classifiers": [
+            "4a334cd4-fe9c-4fae-af4b-321c5e2eb051"
+        ],
+        "chain_parameters": {
+            "correlation": "mpls",
+            "symmetric": true
+        },
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "port_chain": {
+        "name": "inspect-web",
+        "description": "Steers web traffic through the firewalls",
+        "port_pair_groups": [
+            "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+            "4a634d49-76dc-4fae-af4b-321c5e23d651"
+        ],
+        "flow_classifiers": [
+            "4a334cd4-fe9c-4fae-af4b-321c5e2eb051"
+        ],
+        "chain_parameters": {
+            "correlation": "mpls",
+            "symmetric": true
+        }
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "port_chain": {
+        "flow_classifiers": [
+            "4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+            "105a4b0a-73d6-11e5-b392-2c27d72acb4c"
+        ]
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "port_chain": {
+        "id": "1278dcd4-459f-62ed-754b-87fc5e4a6751",
+        "chain_id": 1,
+        "name": "inspect-web",
+        "description": "Steers web traffic through the firewalls",
+        "port_pair_groups": [
+            "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+            "4a634d49-76dc-4fae-af4b-321c5e23d651"
+        ],
+        "flow_classifiers": [
+            "4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+            "105a4b0a-73d6-11e5-b392-2c27d72acb4c"
+        ],
+        "chain_parameters": {
+            "correlation": "mpls",
+            "symmetric": true
+        },
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5"
+    }
+}
+`
+
+var WebChain = portchains.PortChain{
+	ID:          "1278dcd4-459f-62ed-754b-87fc5e4a6751",
+	ChainID:     1,
+	Name:        "inspect-web",
+	Description: "Steers web traffic through the firewalls",
+	PortPairGroups: []string{
+		"4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+		"4a634d49-76dc-4fae-af4b-321c5e23d651",
+	},
+	FlowClassifiers: []string{"4a334cd4-fe9c-4fae-af4b-321c5e2eb051"},
+	ChainParameters: portchains.ChainParameters{
+		Correlation: portchains.CorrelationMPLS,
+		Symmetric:   true,
+	},
+	TenantID:  "d382007aa9904763a801f68ecf065cf5",
+	ProjectID: "d382007aa9904763a801f68ecf065cf5",
+}
@@ -0,0 +1,139 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/sfc/portchains"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_chains", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+
+	count := 0
+	err := portchains.List(fake.ServiceClient(), portchains.ListOpts{}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := portchains.ExtractPortChains(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []portchains.PortChain{WebChain}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_chains/1278dcd4-459f-62ed-754b-87fc5e4a6751", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	chain, err := portchains.Get(context.TODO(), fake.ServiceClient(), "1278dcd4-459f-62ed-754b-87fc5e4a6751").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &WebChain, chain)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_chains", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	createOpts := portchains.CreateOpts{
+		Name:        "inspect-web",
+		Description: "Steers web traffic through the firewalls",
+		PortPairGroups: []string{
+			"4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+			"4a634d49-76dc-4fae-af4b-321c5e23d651",
+		},
+		FlowClassifiers: []string{"4a334cd4-fe9c-4fae-af4b-321c5e2eb051"},
+		ChainParameters: &portchains.ChainParameters{
+			Correlation: portchains.CorrelationMPLS,
+			Symmetric:   true,
+		},
+	}
+	chain, err := portchains.Create(context.TODO(), fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &WebChain, chain)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := portchains.Create(context.TODO(), fake.ServiceClient(), portchains.CreateOpts{Name: "inspect-web"})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_chains/1278dcd4-459f-62ed-754b-87fc5e4a6751", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	flowClassifiers := []string{
+		"4a334cd4-fe9c-4fae-af4b-321c5e2eb051",
+		"105a4b0a-73d6-11e5-b392-2c27d72acb4c",
+	}
+	updateOpts := portchains.UpdateOpts{FlowClassifiers: &flowClassifiers}
+	chain, err := portchains.Update(context.TODO(), fake.ServiceClient(), "1278dcd4-459f-62ed-754b-87fc5e4a6751", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, flowClassifiers, chain.FlowClassifiers)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_chains/1278dcd4-459f-62ed-754b-87fc5e4a6751", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := portchains.Delete(context.TODO(), fake.ServiceClient(), "1278dcd4-459f-62ed-754b-87fc5e4a6751").ExtractErr()
+	th.AssertNoErr(t, err)
+}
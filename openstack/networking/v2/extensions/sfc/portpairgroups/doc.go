@@ -0,0 +1,77 @@
+/*
+Package portpairgroups contains functionality for working with networking-sfc
+port pair groups.
+
+A port pair group is a set of equivalent port pairs, between which traffic is
+load balanced. Each port pair group is one hop of a port chain. A group with
+TapEnabled set receives a copy of the traffic, e.g. for an intrusion detection
+system, without the traffic being steered back into the chain.
+
+Example to List Port Pair Groups
+
+	allPages, err := portpairgroups.List(networkClient, nil).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allGroups, err := portpairgroups.ExtractPortPairGroups(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, group := range allGroups {
+		fmt.Printf("%+v\n", group)
+	}
+
+Example to Create a Port Pair Group
+
+	createOpts := portpairgroups.CreateOpts{
+		Name:      "firewalls",
+		PortPairs: []string{"78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae"},
+		PortPairGroupParameters: &portpairgroups.PortPairGroupParameters{
+			LBFields: []string{"ip_src", "ip_dst"},
+		},
+	}
+
+	group, err := portpairgroups.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Tap Port Pair Group
+
+	tapEnabled := true
+	createOpts := portpairgroups.CreateOpts{
+		Name:       "ids",
+		PortPairs:  []string{"d3e0e6b1-1e5f-4a7c-8c1b-2f6b9b0d3a11"},
+		TapEnabled: &tapEnabled,
+	}
+
+	group, err := portpairgroups.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Replace the Port Pairs of a Port Pair Group
+
+	portPairs := []string{
+		"78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+		"2b4c4d5e-3f9a-4d2b-9b0c-0f5e7c1d2a3b",
+	}
+	updateOpts := portpairgroups.UpdateOpts{
+		PortPairs: &portPairs,
+	}
+
+	group, err := portpairgroups.Update(context.TODO(), networkClient, groupID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Port Pair Group
+
+	err := portpairgroups.Delete(context.TODO(), networkClient, groupID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package portpairgroups
@@ -0,0 +1,153 @@
+package portpairgroups
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToPortPairGroupListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the port pair group attributes you want to see returned. SortKey allows you
+// to sort by a particular port pair group attribute. SortDir sets the
+// direction, and is either `asc' or `desc'. Marker and Limit are used for
+// pagination.
+type ListOpts struct {
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	TenantID    string `q:"tenant_id"`
+	ProjectID   string `q:"project_id"`
+	TapEnabled  *bool  `q:"tap_enabled"`
+	Limit       int    `q:"limit"`
+	Marker      string `q:"marker"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToPortPairGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPortPairGroupListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of port
+// pair groups. It accepts a ListOpts struct, which allows you to filter and
+// sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToPortPairGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return PortPairGroupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific port pair group based on its unique ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, getURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToPortPairGroupCreateMap() (map[string]any, error)
+}
+
+// CreateOpts represents options used to create a port pair group.
+type CreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	// PortPairs are the IDs of the port pairs of the group. Traffic is load
+	// balanced between them.
+	PortPairs []string `json:"port_pairs,omitempty"`
+
+	// PortPairGroupParameters sets how traffic is load balanced between the
+	// port pairs.
+	PortPairGroupParameters *PortPairGroupParameters `json:"port_pair_group_parameters,omitempty"`
+
+	// TapEnabled makes the service functions of the group passive: they
+	// receive a copy of the traffic, which is not steered back into the
+	// chain.
+	TapEnabled *bool `json:"tap_enabled,omitempty"`
+
+	// ProjectID is the project which owns the port pair group. Only
+	// administrative users can specify a project other than their own.
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// ToPortPairGroupCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToPortPairGroupCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "port_pair_group")
+}
+
+// Create accepts a CreateOpts struct and creates a new port pair group using
+// the values provided.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToPortPairGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToPortPairGroupUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts represents options used to update a port pair group.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// PortPairs replaces the port pairs of the group.
+	PortPairs *[]string `json:"port_pairs,omitempty"`
+}
+
+// ToPortPairGroupUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToPortPairGroupUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "port_pair_group")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing port pair group
+// using the values provided.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToPortPairGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, updateURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete accepts a unique ID and deletes the port pair group associated with
+// it. A port pair group cannot be deleted while it belongs to a port chain.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, deleteURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,143 @@
+package portpairgroups
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a PortPairGroup.
+func (r commonResult) Extract() (*PortPairGroup, error) {
+	var s PortPairGroup
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v any) error {
+	return r.Result.ExtractIntoStructPtr(v, "port_pair_group")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a PortPairGroup.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a PortPairGroup.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a PortPairGroup.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// PortPairGroup represents a set of equivalent port pairs, forming one hop of
+// a port chain.
+type PortPairGroup struct {
+	// ID is the UUID of the port pair group.
+	ID string `json:"id"`
+
+	// GroupID is the numeric ID Neutron assigns to the group.
+	GroupID int `json:"group_id"`
+
+	// Name is the human-readable name of the port pair group.
+	Name string `json:"name"`
+
+	// Description of the port pair group.
+	Description string `json:"description"`
+
+	// PortPairs are the IDs of the port pairs of the group.
+	PortPairs []string `json:"port_pairs"`
+
+	// PortPairGroupParameters are the load balancing parameters of the
+	// group.
+	PortPairGroupParameters PortPairGroupParameters `json:"port_pair_group_parameters"`
+
+	// TapEnabled is true when the service functions of the group receive a
+	// copy of the traffic.
+	TapEnabled bool `json:"tap_enabled"`
+
+	// TenantID is the project owner of the port pair group.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the port pair group.
+	ProjectID string `json:"project_id"`
+}
+
+// PortPairGroupParameters are the load balancing parameters of a port pair
+// group.
+type PortPairGroupParameters struct {
+	// LBFields are the packet fields hashed to pick a port pair, e.g.
+	// "ip_src" and "ip_dst".
+	LBFields []string `json:"lb_fields,omitempty"`
+
+	// NTupleMapping rewrites the n-tuple of the flow classifiers for traffic
+	// entering and leaving the group, for service functions which change
+	// packet headers, such as NAT.
+	NTupleMapping *NTupleMapping `json:"ppg_n_tuple_mapping,omitempty"`
+}
+
+// NTupleMapping holds the flow classifier attributes, such as
+// "source_ip_prefix" or "destination_port_range_min", matched on traffic
+// entering and leaving a port pair group.
+type NTupleMapping struct {
+	IngressNTuple map[string]any `json:"ingress_n_tuple,omitempty"`
+	EgressNTuple  map[string]any `json:"egress_n_tuple,omitempty"`
+}
+
+// PortPairGroupPage is the page returned by a pager when traversing over a
+// collection of port pair groups.
+type PortPairGroupPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of port pair groups has
+// reached the end of a page and the pager seeks to traverse over a new one. In
+// order to do this, it needs to construct the next page's URL.
+func (r PortPairGroupPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"port_pair_groups_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a PortPairGroupPage struct is empty.
+func (r PortPairGroupPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractPortPairGroups(r)
+	return len(is) == 0, err
+}
+
+// ExtractPortPairGroups accepts a Page struct, specifically a PortPairGroupPage
+// struct, and extracts the elements into a slice of PortPairGroup structs.
+func ExtractPortPairGroups(r pagination.Page) ([]PortPairGroup, error) {
+	var s []PortPairGroup
+	err := ExtractPortPairGroupsInto(r, &s)
+	return s, err
+}
+
+// ExtractPortPairGroupsInto extracts the elements into a slice of PortPairGroup
+// structs.
+func ExtractPortPairGroupsInto(r pagination.Page, v any) error {
+	return r.(PortPairGroupPage).Result.ExtractIntoSlicePtr(v, "port_pair_groups")
+}
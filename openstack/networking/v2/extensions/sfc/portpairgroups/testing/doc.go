@@ -0,0 +1,2 @@
+// Package testing includes port pair groups unit tests
+package testing
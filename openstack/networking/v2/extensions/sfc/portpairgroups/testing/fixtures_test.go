@@ -0,0 +1,175 @@
+package testing
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/sfc/portpairgroups"
+)
+
+const ListResponse = `
+{
+    "port_pair_groups": [
+        {
+            "id": "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+            "group_id": 1,
+            "name": "firewalls",
+            "description": "Firewall SF instances",
+            "port_pairs": [
+                "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae"
+            ],
+            "port_pair_group_parameters": {
+                "lb_fields": ["ip_src", "ip_dst"],
+                "ppg_n_tuple_mapping": {
+                    "ingress_n_tuple": {"source_ip_prefix": "10.0.0.0/24"},
+                    "egress_n_tuple": {"source_ip_prefix": "192.0.2.0/24"}
+                }
+            },
+            "tap_enabled": false,
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5"
+        },
+        {
+            "id": "a2b58f5d-9b4c-4e6a-8c3d-7f1e0d2c4b6a",
+            "group_id": 2,
+            "name": "ids",
+            "description": "",
+            "port_pairs": [
+                "d3e0e6b1-1e5f-4a7c-8c1b-2f6b9b0d3a11"
+            ],
+            "port_pair_group_parameters": {
+                "lb_fields": [],
+                "ppg_n_tuple_mapping": {
+                    "ingress_n_tuple": {},
+                    "egress_n_tuple": {}
+                }
+            },
+            "tap_enabled": true,
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "port_pair_group": {
+        "id": "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+        "group_id": 1,
+        "name": "firewalls",
+        "description": "Firewall SF instances",
+        "port_pairs": [
+            "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae"
+        ],
+        "port_pair_group_parameters": {
+            "lb_fields": ["ip_src", "ip_dst"],
+            "ppg_n_tuple_mapping": {
+                "ingress_n_tuple": {"source_ip_prefix": "10.0.0.0/24"},
+                "egress_n_tuple": {"source_ip_prefix": "192.0.2.0/24"}
+            }
+        },
+        "tap_enabled": false,
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "port_pair_group": {
+        "name": "firewalls",
+        "description": "Firewall SF instances",
+        "port_pairs": [
+            "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae"
+        ],
+        "port_pair_group_parameters": {
+            "lb_fields": ["ip_src", "ip_dst"],
+            "ppg_n_tuple_mapping": {
+                "ingress_n_tuple": {"source_ip_prefix": "10.0.0.0/24"},
+                "egress_n_tuple": {"source_ip_prefix": "192.0.2.0/24"}
+            }
+        }
+    }
+}
+`
+
+const CreateTapRequest = `
+{
+    "port_pair_group": {
+        "name": "ids",
+        "port_pairs": [
+            "d3e0e6b1-1e5f-4a7c-8c1b-2f6b9b0d3a11"
+        ],
+        "tap_enabled": true
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "port_pair_group": {
+        "port_pairs": [
+            "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+            "2b4c4d5e-3f9a-4d2b-9b0c-0f5e7c1d2a3b"
+        ]
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "port_pair_group": {
+        "id": "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+        "group_id": 1,
+        "name": "firewalls",
+        "description": "Firewall SF instances",
+        "port_pairs": [
+            "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+            "2b4c4d5e-3f9a-4d2b-9b0c-0f5e7c1d2a3b"
+        ],
+        "port_pair_group_parameters": {
+            "lb_fields": ["ip_src", "ip_dst"],
+            "ppg_n_tuple_mapping": {
+                "ingress_n_tuple": {"source_ip_prefix": "10.0.0.0/24"},
+                "egress_n_tuple": {"source_ip_prefix": "192.0.2.0/24"}
+            }
+        },
+        "tap_enabled": false,
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5"
+    }
+}
+`
+
+var FirewallGroup = portpairgroups.PortPairGroup{
+	ID:          "4512d643-24fc-4fae-af4b-321c5e2eb3d1",
+	GroupID:     1,
+	Name:        "firewalls",
+	Description: "Firewall SF instances",
+	PortPairs:   []string{"78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae"},
+	PortPairGroupParameters: portpairgroups.PortPairGroupParameters{
+		LBFields: []string{"ip_src", "ip_dst"},
+		NTupleMapping: &portpairgroups.NTupleMapping{
+			IngressNTuple: map[string]any{"source_ip_prefix": "10.0.0.0/24"},
+			EgressNTuple:  map[string]any{"source_ip_prefix": "192.0.2.0/24"},
+		},
+	},
+	TenantID:  "d382007aa9904763a801f68ecf065cf5",
+	ProjectID: "d382007aa9904763a801f68ecf065cf5",
+}
+
+var IDSGroup = portpairgroups.PortPairGroup{
+	ID:        "a2b58f5d-9b4c-4e6a-8c3d-7f1e0d2c4b6a",
+	GroupID:   2,
+	Name:      "ids",
+	PortPairs: []string{"d3e0e6b1-1e5f-4a7c-8c1b-2f6b9b0d3a11"},
+	PortPairGroupParameters: portpairgroups.PortPairGroupParameters{
+		LBFields: []string{},
+		NTupleMapping: &portpairgroups.NTupleMapping{
+			IngressNTuple: map[string]any{},
+			EgressNTuple:  map[string]any{},
+		},
+	},
+	TapEnabled: true,
+	TenantID:   "d382007aa9904763a801f68ecf065cf5",
+	ProjectID:  "d382007aa9904763a801f68ecf065cf5",
+}
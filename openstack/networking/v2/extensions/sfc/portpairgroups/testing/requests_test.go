@@ -0,0 +1,180 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/sfc/portpairgroups"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pair_groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+
+	count := 0
+	err := portpairgroups.List(fake.ServiceClient(), portpairgroups.ListOpts{}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := portpairgroups.ExtractPortPairGroups(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []portpairgroups.PortPairGroup{FirewallGroup, IDSGroup}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestListTapEnabled(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pair_groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"tap_enabled": "true"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `{"port_pair_groups": []}`)
+	})
+
+	tapEnabled := true
+	allPages, err := portpairgroups.List(fake.ServiceClient(), portpairgroups.ListOpts{TapEnabled: &tapEnabled}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	actual, err := portpairgroups.ExtractPortPairGroups(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(actual))
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pair_groups/4512d643-24fc-4fae-af4b-321c5e2eb3d1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	group, err := portpairgroups.Get(context.TODO(), fake.ServiceClient(), "4512d643-24fc-4fae-af4b-321c5e2eb3d1").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirewallGroup, group)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pair_groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	createOpts := portpairgroups.CreateOpts{
+		Name:        "firewalls",
+		Description: "Firewall SF instances",
+		PortPairs:   []string{"78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae"},
+		PortPairGroupParameters: &portpairgroups.PortPairGroupParameters{
+			LBFields: []string{"ip_src", "ip_dst"},
+			NTupleMapping: &portpairgroups.NTupleMapping{
+				IngressNTuple: map[string]any{"source_ip_prefix": "10.0.0.0/24"},
+				EgressNTuple:  map[string]any{"source_ip_prefix": "192.0.2.0/24"},
+			},
+		},
+	}
+	group, err := portpairgroups.Create(context.TODO(), fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirewallGroup, group)
+}
+
+func TestCreateTap(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pair_groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateTapRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, `{"port_pair_group": {"id": "a2b58f5d-9b4c-4e6a-8c3d-7f1e0d2c4b6a", "tap_enabled": true}}`)
+	})
+
+	tapEnabled := true
+	createOpts := portpairgroups.CreateOpts{
+		Name:       "ids",
+		PortPairs:  []string{"d3e0e6b1-1e5f-4a7c-8c1b-2f6b9b0d3a11"},
+		TapEnabled: &tapEnabled,
+	}
+	group, err := portpairgroups.Create(context.TODO(), fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, group.TapEnabled)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pair_groups/4512d643-24fc-4fae-af4b-321c5e2eb3d1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	portPairs := []string{
+		"78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+		"2b4c4d5e-3f9a-4d2b-9b0c-0f5e7c1d2a3b",
+	}
+	updateOpts := portpairgroups.UpdateOpts{PortPairs: &portPairs}
+	group, err := portpairgroups.Update(context.TODO(), fake.ServiceClient(), "4512d643-24fc-4fae-af4b-321c5e2eb3d1", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, portPairs, group.PortPairs)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pair_groups/4512d643-24fc-4fae-af4b-321c5e2eb3d1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := portpairgroups.Delete(context.TODO(), fake.ServiceClient(), "4512d643-24fc-4fae-af4b-321c5e2eb3d1").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,65 @@
+/*
+Package portpairs contains functionality for working with networking-sfc port
+pairs.
+
+A port pair is the ingress and egress ports of a service function instance,
+such as a firewall appliance. Port pairs are grouped into port pair groups,
+which form the hops of a port chain.
+
+Example to List Port Pairs
+
+	listOpts := portpairs.ListOpts{
+		Ingress: "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+	}
+
+	allPages, err := portpairs.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allPortPairs, err := portpairs.ExtractPortPairs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, portPair := range allPortPairs {
+		fmt.Printf("%+v\n", portPair)
+	}
+
+Example to Create a Port Pair
+
+	createOpts := portpairs.CreateOpts{
+		Name:    "firewall-1",
+		Ingress: "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+		Egress:  "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+		ServiceFunctionParameters: &portpairs.ServiceFunctionParameters{
+			Correlation: portpairs.CorrelationMPLS,
+			Weight:      1,
+		},
+	}
+
+	portPair, err := portpairs.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Port Pair
+
+	name := "firewall-one"
+	updateOpts := portpairs.UpdateOpts{
+		Name: &name,
+	}
+
+	portPair, err := portpairs.Update(context.TODO(), networkClient, portPairID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Port Pair
+
+	err := portpairs.Delete(context.TODO(), networkClient, portPairID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package portpairs
@@ -0,0 +1,156 @@
+package portpairs
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// Correlation values for the ServiceFunctionParameters of a port pair, which
+// set the encapsulation understood by the service function.
+const (
+	CorrelationMPLS = "mpls"
+	CorrelationNSH  = "nsh"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToPortPairListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the port pair attributes you want to see returned. SortKey allows you to sort
+// by a particular port pair attribute. SortDir sets the direction, and is
+// either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	TenantID    string `q:"tenant_id"`
+	ProjectID   string `q:"project_id"`
+	Ingress     string `q:"ingress"`
+	Egress      string `q:"egress"`
+	Limit       int    `q:"limit"`
+	Marker      string `q:"marker"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToPortPairListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPortPairListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// port pairs. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToPortPairListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return PortPairPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific port pair based on its unique ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, getURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToPortPairCreateMap() (map[string]any, error)
+}
+
+// CreateOpts represents options used to create a port pair.
+type CreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	// Ingress is the ID of the port traffic enters the service function
+	// through.
+	Ingress string `json:"ingress" required:"true"`
+
+	// Egress is the ID of the port traffic leaves the service function
+	// through. It may be the same as Ingress.
+	Egress string `json:"egress" required:"true"`
+
+	// ServiceFunctionParameters sets the correlation and weight of the port
+	// pair.
+	ServiceFunctionParameters *ServiceFunctionParameters `json:"service_function_parameters,omitempty"`
+
+	// ProjectID is the project which owns the port pair. Only administrative
+	// users can specify a project other than their own.
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// ToPortPairCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToPortPairCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "port_pair")
+}
+
+// Create accepts a CreateOpts struct and creates a new port pair using the
+// values provided.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToPortPairCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToPortPairUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts represents options used to update a port pair.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToPortPairUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToPortPairUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "port_pair")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing port pair using
+// the values provided.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToPortPairUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, updateURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete accepts a unique ID and deletes the port pair associated with it.
+// A port pair cannot be deleted while it belongs to a port pair group.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, deleteURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
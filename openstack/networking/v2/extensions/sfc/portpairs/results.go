@@ -0,0 +1,130 @@
+package portpairs
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a PortPair.
+func (r commonResult) Extract() (*PortPair, error) {
+	var s PortPair
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v any) error {
+	return r.Result.ExtractIntoStructPtr(v, "port_pair")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a PortPair.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a PortPair.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a PortPair.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// PortPair represents the ingress and egress ports of a service function
+// instance, such as a firewall appliance.
+type PortPair struct {
+	// ID is the UUID of the port pair.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the port pair.
+	Name string `json:"name"`
+
+	// Description of the port pair.
+	Description string `json:"description"`
+
+	// Ingress is the ID of the ingress port of the service function.
+	Ingress string `json:"ingress"`
+
+	// Egress is the ID of the egress port of the service function.
+	Egress string `json:"egress"`
+
+	// ServiceFunctionParameters are the correlation and weight of the port
+	// pair.
+	ServiceFunctionParameters ServiceFunctionParameters `json:"service_function_parameters"`
+
+	// TenantID is the project owner of the port pair.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the port pair.
+	ProjectID string `json:"project_id"`
+}
+
+// ServiceFunctionParameters are the parameters of the service function
+// behind a port pair.
+type ServiceFunctionParameters struct {
+	// Correlation is the encapsulation the service function understands, one
+	// of CorrelationMPLS or CorrelationNSH. It is empty when the service
+	// function is not aware of the chain.
+	Correlation string `json:"correlation,omitempty"`
+
+	// Weight is the share of the traffic of the port pair group sent to this
+	// port pair.
+	Weight int `json:"weight,omitempty"`
+}
+
+// PortPairPage is the page returned by a pager when traversing over a
+// collection of port pairs.
+type PortPairPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of port pairs has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r PortPairPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"port_pairs_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a PortPairPage struct is empty.
+func (r PortPairPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractPortPairs(r)
+	return len(is) == 0, err
+}
+
+// ExtractPortPairs accepts a Page struct, specifically a PortPairPage struct,
+// and extracts the elements into a slice of PortPair structs.
+func ExtractPortPairs(r pagination.Page) ([]PortPair, error) {
+	var s []PortPair
+	err := ExtractPortPairsInto(r, &s)
+	return s, err
+}
+
+// ExtractPortPairsInto extracts the elements into a slice of PortPair structs.
+func ExtractPortPairsInto(r pagination.Page, v any) error {
+	return r.(PortPairPage).Result.ExtractIntoSlicePtr(v, "port_pairs")
+}
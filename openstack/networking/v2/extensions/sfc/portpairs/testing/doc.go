@@ -0,0 +1,2 @@
+// Package testing includes port pairs unit tests
+package testing
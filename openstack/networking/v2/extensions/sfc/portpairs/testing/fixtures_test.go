@@ -0,0 +1,124 @@
+package testing
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/sfc/portpairs"
+)
+
+const ListResponse = `
+{
+    "port_pairs": [
+        {
+            "id": "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+            "name": "firewall-1",
+            "description": "Firewall SF instance",
+            "ingress": "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+            "egress": "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+            "service_function_parameters": {
+                "correlation": "mpls",
+                "weight": 1
+            },
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5"
+        },
+        {
+            "id": "d3e0e6b1-1e5f-4a7c-8c1b-2f6b9b0d3a11",
+            "name": "ids-1",
+            "description": "",
+            "ingress": "3b2a5c1e-6d7f-4e8a-9b0c-1d2e3f4a5b6c",
+            "egress": "3b2a5c1e-6d7f-4e8a-9b0c-1d2e3f4a5b6c",
+            "service_function_parameters": {
+                "correlation": null,
+                "weight": 1
+            },
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "port_pair": {
+        "id": "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+        "name": "firewall-1",
+        "description": "Firewall SF instance",
+        "ingress": "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+        "egress": "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+        "service_function_parameters": {
+            "correlation": "mpls",
+            "weight": 1
+        },
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "port_pair": {
+        "name": "firewall-1",
+        "description": "Firewall SF instance",
+        "ingress": "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+        "egress": "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+        "service_function_parameters": {
+            "correlation": "mpls",
+            "weight": 1
+        }
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "port_pair": {
+        "name": "firewall-one",
+        "description": ""
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "port_pair": {
+        "id": "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+        "name": "firewall-one",
+        "description": "",
+        "ingress": "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+        "egress": "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+        "service_function_parameters": {
+            "correlation": "mpls",
+            "weight": 1
+        },
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5"
+    }
+}
+`
+
+var FirewallPortPair = portpairs.PortPair{
+	ID:          "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae",
+	Name:        "firewall-1",
+	Description: "Firewall SF instance",
+	Ingress:     "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+	Egress:      "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+	ServiceFunctionParameters: portpairs.ServiceFunctionParameters{
+		Correlation: portpairs.CorrelationMPLS,
+		Weight:      1,
+	},
+	TenantID:  "d382007aa9904763a801f68ecf065cf5",
+	ProjectID: "d382007aa9904763a801f68ecf065cf5",
+}
+
+var IDSPortPair = portpairs.PortPair{
+	ID:      "d3e0e6b1-1e5f-4a7c-8c1b-2f6b9b0d3a11",
+	Name:    "ids-1",
+	Ingress: "3b2a5c1e-6d7f-4e8a-9b0c-1d2e3f4a5b6c",
+	Egress:  "3b2a5c1e-6d7f-4e8a-9b0c-1d2e3f4a5b6c",
+	ServiceFunctionParameters: portpairs.ServiceFunctionParameters{
+		Weight: 1,
+	},
+	TenantID:  "d382007aa9904763a801f68ecf065cf5",
+	ProjectID: "d382007aa9904763a801f68ecf065cf5",
+}
@@ -0,0 +1,137 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/sfc/portpairs"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pairs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"project_id": "d382007aa9904763a801f68ecf065cf5"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+
+	count := 0
+	listOpts := portpairs.ListOpts{ProjectID: "d382007aa9904763a801f68ecf065cf5"}
+	err := portpairs.List(fake.ServiceClient(), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := portpairs.ExtractPortPairs(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []portpairs.PortPair{FirewallPortPair, IDSPortPair}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pairs/78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	portPair, err := portpairs.Get(context.TODO(), fake.ServiceClient(), "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirewallPortPair, portPair)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pairs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	createOpts := portpairs.CreateOpts{
+		Name:        "firewall-1",
+		Description: "Firewall SF instance",
+		Ingress:     "dace4513-24fc-4fae-af4b-321c5e2eb3d1",
+		Egress:      "aef3478a-4a56-2a6e-cd3a-9dee4e2ec345",
+		ServiceFunctionParameters: &portpairs.ServiceFunctionParameters{
+			Correlation: portpairs.CorrelationMPLS,
+			Weight:      1,
+		},
+	}
+	portPair, err := portpairs.Create(context.TODO(), fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirewallPortPair, portPair)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := portpairs.Create(context.TODO(), fake.ServiceClient(), portpairs.CreateOpts{Ingress: "dace4513-24fc-4fae-af4b-321c5e2eb3d1"})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pairs/78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	name := "firewall-one"
+	description := ""
+	updateOpts := portpairs.UpdateOpts{Name: &name, Description: &description}
+	portPair, err := portpairs.Update(context.TODO(), fake.ServiceClient(), "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "firewall-one", portPair.Name)
+	th.AssertEquals(t, "", portPair.Description)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/port_pairs/78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := portpairs.Delete(context.TODO(), fake.ServiceClient(), "78dcd363-fc23-aeb6-f44b-56dc5e2fb3ae").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,58 @@
+/*
+Package servicegraphs contains functionality for working with networking-sfc
+service graphs.
+
+A service graph links port chains together: traffic leaving a port chain is
+steered into the port chains it maps to, which lets traffic branch into
+different chains depending on their flow classifiers.
+
+Example to List Service Graphs
+
+	allPages, err := servicegraphs.List(networkClient, nil).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allGraphs, err := servicegraphs.ExtractServiceGraphs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, graph := range allGraphs {
+		fmt.Printf("%+v\n", graph)
+	}
+
+Example to Create a Service Graph
+
+	createOpts := servicegraphs.CreateOpts{
+		Name: "web-then-db",
+		PortChains: map[string][]string{
+			webChainID: {dbChainID, cacheChainID},
+		},
+	}
+
+	graph, err := servicegraphs.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Service Graph
+
+	name := "web-then-backends"
+	updateOpts := servicegraphs.UpdateOpts{
+		Name: &name,
+	}
+
+	graph, err := servicegraphs.Update(context.TODO(), networkClient, graphID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Service Graph
+
+	err := servicegraphs.Delete(context.TODO(), networkClient, graphID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package servicegraphs
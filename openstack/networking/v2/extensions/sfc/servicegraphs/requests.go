@@ -0,0 +1,140 @@
+package servicegraphs
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToServiceGraphListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the service graph attributes you want to see returned. SortKey allows you to
+// sort by a particular service graph attribute. SortDir sets the direction, and
+// is either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	TenantID    string `q:"tenant_id"`
+	ProjectID   string `q:"project_id"`
+	Limit       int    `q:"limit"`
+	Marker      string `q:"marker"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+}
+
+// ToServiceGraphListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToServiceGraphListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of service
+// graphs. It accepts a ListOpts struct, which allows you to filter and sort the
+// returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToServiceGraphListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return ServiceGraphPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific service graph based on its unique ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, getURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToServiceGraphCreateMap() (map[string]any, error)
+}
+
+// CreateOpts represents options used to create a service graph.
+type CreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	// PortChains maps the ID of a port chain to the IDs of the port chains
+	// its traffic continues into.
+	PortChains map[string][]string `json:"port_chains" required:"true"`
+
+	// ProjectID is the project which owns the service graph. Only
+	// administrative users can specify a project other than their own.
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// ToServiceGraphCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToServiceGraphCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "service_graph")
+}
+
+// Create accepts a CreateOpts struct and creates a new service graph using the
+// values provided.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToServiceGraphCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToServiceGraphUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts represents options used to update a service graph. The port
+// chains of a service graph cannot be changed.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToServiceGraphUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToServiceGraphUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "service_graph")
+}
+
+// Update accepts a UpdateOpts struct and updates an existing service graph
+// using the values provided.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToServiceGraphUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, updateURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete accepts a unique ID and deletes the service graph associated with
+// it.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, deleteURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
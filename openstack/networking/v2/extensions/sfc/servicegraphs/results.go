@@ -0,0 +1,112 @@
+package servicegraphs
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a ServiceGraph.
+func (r commonResult) Extract() (*ServiceGraph, error) {
+	var s ServiceGraph
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v any) error {
+	return r.Result.ExtractIntoStructPtr(v, "service_graph")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a ServiceGraph.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a ServiceGraph.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a ServiceGraph.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ServiceGraph represents dependencies between port chains, so that traffic
+// leaving one chain is steered into the next ones.
+type ServiceGraph struct {
+	// ID is the UUID of the service graph.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the service graph.
+	Name string `json:"name"`
+
+	// Description of the service graph.
+	Description string `json:"description"`
+
+	// PortChains maps the ID of a port chain to the IDs of the port chains
+	// its traffic continues into.
+	PortChains map[string][]string `json:"port_chains"`
+
+	// TenantID is the project owner of the service graph.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the service graph.
+	ProjectID string `json:"project_id"`
+}
+
+// ServiceGraphPage is the page returned by a pager when traversing over a
+// collection of service graphs.
+type ServiceGraphPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of service graphs has
+// reached the end of a page and the pager seeks to traverse over a new one. In
+// order to do this, it needs to construct the next page's URL.
+func (r ServiceGraphPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"service_graphs_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a ServiceGraphPage struct is empty.
+func (r ServiceGraphPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractServiceGraphs(r)
+	return len(is) == 0, err
+}
+
+// ExtractServiceGraphs accepts a Page struct, specifically a ServiceGraphPage
+// struct, and extracts the elements into a slice of ServiceGraph structs.
+func ExtractServiceGraphs(r pagination.Page) ([]ServiceGraph, error) {
+	var s []ServiceGraph
+	err := ExtractServiceGraphsInto(r, &s)
+	return s, err
+}
+
+// ExtractServiceGraphsInto extracts the elements into a slice of ServiceGraph
+// structs.
+func ExtractServiceGraphsInto(r pagination.Page, v any) error {
+	return r.(ServiceGraphPage).Result.ExtractIntoSlicePtr(v, "service_graphs")
+}
@@ -0,0 +1,2 @@
+// Package testing includes service graphs unit tests
+package testing
@@ -0,0 +1,96 @@
+package testing
+
+import (
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/sfc/servicegraphs"
+)
+
+const ListResponse = `
+{
+    "service_graphs": [
+        {
+            "id": "0e7c4bd1-5b59-4c5a-9ab4-2a3b7c6d8e9f",
+            "name": "web-then-backends",
+            "description": "",
+            "port_chains": {
+                "1278dcd4-459f-62ed-754b-87fc5e4a6751": [
+                    "c2a0e9b4-6f1d-4e1b-9b3c-7d8e9f0a1b2c",
+                    "f5e4d3c2-b1a0-4f9e-8d7c-6b5a4f3e2d1c"
+                ]
+            },
+            "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+            "project_id": "d382007aa9904763a801f68ecf065cf5"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "service_graph": {
+        "id": "0e7c4bd1-5b59-4c5a-9ab4-2a3b7c6d8e9f",
+        "name": "web-then-backends",
+        "description": "",
+        "port_chains": {
+            "1278dcd4-459f-62ed-754b-87fc5e4a6751": [
+                "c2a0e9b4-6f1d-4e1b-9b3c-7d8e9f0a1b2c",
+                "f5e4d3c2-b1a0-4f9e-8d7c-6b5a4f3e2d1c"
+            ]
+        },
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "service_graph": {
+        "name": "web-then-backends",
+        "port_chains": {
+            "1278dcd4-459f-62ed-754b-87fc5e4a6751": [
+                "c2a0e9b4-6f1d-4e1b-9b3c-7d8e9f0a1b2c",
+                "f5e4d3c2-b1a0-4f9e-8d7c-6b5a4f3e2d1c"
+            ]
+        }
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "service_graph": {
+        "description": "Web chain branching into the database and cache chains"
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "service_graph": {
+        "id": "0e7c4bd1-5b59-4c5a-9ab4-2a3b7c6d8e9f",
+        "name": "web-then-backends",
+        "description": "Web chain branching into the database and cache chains",
+        "port_chains": {
+            "1278dcd4-459f-62ed-754b-87fc5e4a6751": [
+                "c2a0e9b4-6f1d-4e1b-9b3c-7d8e9f0a1b2c",
+                "f5e4d3c2-b1a0-4f9e-8d7c-6b5a4f3e2d1c"
+            ]
+        },
+        "tenant_id": "d382007aa9904763a801f68ecf065cf5",
+        "project_id": "d382007aa9904763a801f68ecf065cf5"
+    }
+}
+`
+
+var WebGraph = servicegraphs.ServiceGraph{
+	ID:   "0e7c4bd1-5b59-4c5a-9ab4-2a3b7c6d8e9f",
+	Name: "web-then-backends",
+	PortChains: map[string][]string{
+		"1278dcd4-459f-62ed-754b-87fc5e4a6751": {
+			"c2a0e9b4-6f1d-4e1b-9b3c-7d8e9f0a1b2c",
+			"f5e4d3c2-b1a0-4f9e-8d7c-6b5a4f3e2d1c",
+		},
+	},
+	TenantID:  "d382007aa9904763a801f68ecf065cf5",
+	ProjectID: "d382007aa9904763a801f68ecf065cf5",
+}
@@ -0,0 +1,131 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/sfc/servicegraphs"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/service_graphs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+
+	count := 0
+	err := servicegraphs.List(fake.ServiceClient(), servicegraphs.ListOpts{}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := servicegraphs.ExtractServiceGraphs(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []servicegraphs.ServiceGraph{WebGraph}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/service_graphs/0e7c4bd1-5b59-4c5a-9ab4-2a3b7c6d8e9f", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	graph, err := servicegraphs.Get(context.TODO(), fake.ServiceClient(), "0e7c4bd1-5b59-4c5a-9ab4-2a3b7c6d8e9f").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &WebGraph, graph)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/service_graphs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, GetResponse)
+	})
+
+	createOpts := servicegraphs.CreateOpts{
+		Name: "web-then-backends",
+		PortChains: map[string][]string{
+			"1278dcd4-459f-62ed-754b-87fc5e4a6751": {
+				"c2a0e9b4-6f1d-4e1b-9b3c-7d8e9f0a1b2c",
+				"f5e4d3c2-b1a0-4f9e-8d7c-6b5a4f3e2d1c",
+			},
+		},
+	}
+	graph, err := servicegraphs.Create(context.TODO(), fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &WebGraph, graph)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := servicegraphs.Create(context.TODO(), fake.ServiceClient(), servicegraphs.CreateOpts{Name: "web-then-backends"})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/service_graphs/0e7c4bd1-5b59-4c5a-9ab4-2a3b7c6d8e9f", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	description := "Web chain branching into the database and cache chains"
+	graph, err := servicegraphs.Update(context.TODO(), fake.ServiceClient(), "0e7c4bd1-5b59-4c5a-9ab4-2a3b7c6d8e9f", servicegraphs.UpdateOpts{Description: &description}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, description, graph.Description)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/sfc/service_graphs/0e7c4bd1-5b59-4c5a-9ab4-2a3b7c6d8e9f", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := servicegraphs.Delete(context.TODO(), fake.ServiceClient(), "0e7c4bd1-5b59-4c5a-9ab4-2a3b7c6d8e9f").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
@@ -108,6 +109,32 @@ func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBu
 	return
 }
 
+// CreateBulk creates several networks in a single request. Extension options,
+// such as those wrapping CreateOpts, may be mixed in opts. Neutron creates
+// the networks atomically: either all of them are created or none is.
+// The created networks are returned in the order of opts.
+func CreateBulk(ctx context.Context, c *gophercloud.ServiceClient, opts []CreateOptsBuilder) (r CreateBulkResult) {
+	items := make([]any, len(opts))
+	for i, o := range opts {
+		b, err := o.ToNetworkCreateMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		item, ok := b["network"]
+		if !ok {
+			r.Err = fmt.Errorf("networks.CreateBulk: options at index %d did not build a network", i)
+			return
+		}
+		items[i] = item
+	}
+
+	b := map[string]any{"networks": items}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
@@ -164,3 +191,15 @@ func Delete(ctx context.Context, c *gophercloud.ServiceClient, networkID string)
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// IDFromName is a convenience function that returns a network's ID given its
+// name. The lookup is filtered by name server-side and matched exactly client-
+// side.
+//
+// It returns a gophercloud.ErrResourceNotFound if no network has that name and
+// a gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	return utils.ResolveID(ctx, List(client, ListOpts{Name: name}), ExtractNetworks, func(n Network) (string, string) {
+		return n.Name, n.ID
+	}, "network", name)
+}
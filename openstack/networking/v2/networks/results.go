@@ -29,6 +29,26 @@ type CreateResult struct {
 	commonResult
 }
 
+// CreateBulkResult represents the result of a bulk create operation. Call
+// its Extract method to interpret it as a slice of Networks.
+type CreateBulkResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the created
+// networks.
+func (r CreateBulkResult) Extract() ([]Network, error) {
+	var s []Network
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// ExtractInto extracts the created networks into v, which should be a pointer
+// to a slice, e.g. of a struct embedding Network and extension fields.
+func (r CreateBulkResult) ExtractInto(v any) error {
+	return r.Result.ExtractIntoSlicePtr(v, "networks")
+}
+
 // GetResult represents the result of a get operation. Call its Extract
 // method to interpret it as a Network.
 type GetResult struct {
@@ -142,6 +142,47 @@ const CreateOptionalFieldsRequest = `
   }
 }`
 
+const CreateBulkRequest = `
+{
+    "networks": [
+        {
+            "name": "private",
+            "admin_state_up": true
+        },
+        {
+            "name": "public",
+            "admin_state_up": true,
+            "port_security_enabled": false
+        }
+    ]
+}`
+
+const CreateBulkResponse = `
+{
+    "networks": [
+        {
+            "status": "ACTIVE",
+            "subnets": [],
+            "name": "private",
+            "admin_state_up": true,
+            "tenant_id": "26a7980765d0414dbc1fc1f88cdb7e6e",
+            "shared": false,
+            "id": "db193ab3-96e3-4cb3-8fc5-05f4296d0324",
+            "port_security_enabled": true
+        },
+        {
+            "status": "ACTIVE",
+            "subnets": [],
+            "name": "public",
+            "admin_state_up": true,
+            "tenant_id": "26a7980765d0414dbc1fc1f88cdb7e6e",
+            "shared": false,
+            "id": "39a3c4ef-2d2b-4e71-9a2a-0b4f7bc52f3a",
+            "port_security_enabled": false
+        }
+    ]
+}`
+
 const UpdateRequest = `
 {
     "network": {
@@ -164,6 +164,41 @@ func TestCreate(t *testing.T) {
 	th.AssertEquals(t, n.UpdatedAt.Format(time.RFC3339), "2019-06-30T05:18:49Z")
 }
 
+func TestCreateBulk(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateBulkRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, CreateBulkResponse)
+	})
+
+	iTrue := true
+	iFalse := false
+	opts := []networks.CreateOptsBuilder{
+		networks.CreateOpts{Name: "private", AdminStateUp: &iTrue},
+		portsecurity.NetworkCreateOptsExt{
+			CreateOptsBuilder:   networks.CreateOpts{Name: "public", AdminStateUp: &iTrue},
+			PortSecurityEnabled: &iFalse,
+		},
+	}
+	n, err := networks.CreateBulk(context.TODO(), fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(n))
+	th.AssertEquals(t, "private", n[0].Name)
+	th.AssertEquals(t, "db193ab3-96e3-4cb3-8fc5-05f4296d0324", n[0].ID)
+	th.AssertEquals(t, "public", n[1].Name)
+	th.AssertEquals(t, "39a3c4ef-2d2b-4e71-9a2a-0b4f7bc52f3a", n[1].ID)
+}
+
 func TestCreateWithOptionalFields(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -354,3 +389,23 @@ func TestUpdatePortSecurity(t *testing.T) {
 	th.AssertEquals(t, networkWithExtensions.ID, "4e8e5957-649f-477b-9e5b-f1f75b21c03c")
 	th.AssertEquals(t, networkWithExtensions.PortSecurityEnabled, false)
 }
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"name": "private"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+
+	id, err := networks.IDFromName(context.TODO(), fake.ServiceClient(), "private")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "db193ab3-96e3-4cb3-8fc5-05f4296d0324", id)
+}
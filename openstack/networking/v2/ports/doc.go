@@ -48,6 +48,27 @@ Example to Create a Port
 		panic(err)
 	}
 
+Example to Create Several Ports at Once
+
+	createOpts := []ports.CreateOptsBuilder{
+		ports.CreateOpts{
+			Name:      "port-1",
+			NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+		},
+		portsbinding.CreateOptsExt{
+			CreateOptsBuilder: ports.CreateOpts{
+				Name:      "port-2",
+				NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+			},
+			HostID: "compute-01",
+		},
+	}
+
+	allPorts, err := ports.CreateBulk(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Update a Port
 
 	portID := "c34bae2b-7641-49b6-bf6d-d8e473620ed8"
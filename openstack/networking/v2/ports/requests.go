@@ -179,6 +179,32 @@ func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBu
 	return
 }
 
+// CreateBulk creates several ports in a single request. Extension options,
+// such as those wrapping CreateOpts, may be mixed in opts. Neutron creates
+// the ports atomically: either all of them are created or none is.
+// The created ports are returned in the order of opts.
+func CreateBulk(ctx context.Context, c *gophercloud.ServiceClient, opts []CreateOptsBuilder) (r CreateBulkResult) {
+	items := make([]any, len(opts))
+	for i, o := range opts {
+		b, err := o.ToPortCreateMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		item, ok := b["port"]
+		if !ok {
+			r.Err = fmt.Errorf("ports.CreateBulk: options at index %d did not build a port", i)
+			return
+		}
+		items[i] = item
+	}
+
+	b := map[string]any{"ports": items}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
@@ -29,6 +29,26 @@ type CreateResult struct {
 	commonResult
 }
 
+// CreateBulkResult represents the result of a bulk create operation. Call
+// its Extract method to interpret it as a slice of Ports.
+type CreateBulkResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the created
+// ports.
+func (r CreateBulkResult) Extract() ([]Port, error) {
+	var s []Port
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// ExtractInto extracts the created ports into v, which should be a pointer
+// to a slice, e.g. of a struct embedding Port and extension fields.
+func (r CreateBulkResult) ExtractInto(v any) error {
+	return r.Result.ExtractIntoSlicePtr(v, "ports")
+}
+
 // GetResult represents the result of a get operation. Call its Extract
 // method to interpret it as a Port.
 type GetResult struct {
@@ -162,6 +182,16 @@ func (r *Port) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// IsRouterInterface reports whether the port is an interface of a router,
+// including distributed and HA routers.
+func (r Port) IsRouterInterface() bool {
+	switch r.DeviceOwner {
+	case "network:router_interface", "network:router_interface_distributed", "network:ha_router_replicated_interface":
+		return true
+	}
+	return false
+}
+
 // PortPage is the page returned by a pager when traversing over a collection
 // of network ports.
 type PortPage struct {
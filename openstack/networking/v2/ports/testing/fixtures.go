@@ -391,6 +391,50 @@ const CreatePortSecurityResponse = `
 }
 `
 
+const CreateBulkRequest = `
+{
+    "ports": [
+        {
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "name": "port-1",
+            "admin_state_up": true
+        },
+        {
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "name": "port-2",
+            "port_security_enabled": false
+        }
+    ]
+}
+`
+
+const CreateBulkResponse = `
+{
+    "ports": [
+        {
+            "status": "DOWN",
+            "name": "port-1",
+            "admin_state_up": true,
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "tenant_id": "d6700c0c9ffa4f1cb322cd4a1f3906fa",
+            "mac_address": "fa:16:3e:c9:cb:f0",
+            "id": "65c0ee9f-d634-4522-8954-51021b570b0d",
+            "port_security_enabled": true
+        },
+        {
+            "status": "DOWN",
+            "name": "port-2",
+            "admin_state_up": true,
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "tenant_id": "d6700c0c9ffa4f1cb322cd4a1f3906fa",
+            "mac_address": "fa:16:3e:c9:cb:f1",
+            "id": "d80b1a3b-4fc1-49f3-952e-1e2ab7081d8b",
+            "port_security_enabled": false
+        }
+    ]
+}
+`
+
 const UpdateRequest = `
 {
     "port": {
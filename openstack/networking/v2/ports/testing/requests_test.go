@@ -217,6 +217,55 @@ func TestCreate(t *testing.T) {
 	})
 }
 
+func TestCreateBulk(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateBulkRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, CreateBulkResponse)
+	})
+
+	asu := true
+	iFalse := false
+	opts := []ports.CreateOptsBuilder{
+		ports.CreateOpts{
+			Name:         "port-1",
+			AdminStateUp: &asu,
+			NetworkID:    "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+		},
+		portsecurity.PortCreateOptsExt{
+			CreateOptsBuilder: ports.CreateOpts{
+				Name:      "port-2",
+				NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+			},
+			PortSecurityEnabled: &iFalse,
+		},
+	}
+
+	var s []struct {
+		ports.Port
+		portsecurity.PortSecurityExt
+	}
+	err := ports.CreateBulk(context.TODO(), fake.ServiceClient(), opts).ExtractInto(&s)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(s))
+	th.AssertEquals(t, "port-1", s[0].Name)
+	th.AssertEquals(t, true, s[0].PortSecurityEnabled)
+	th.AssertEquals(t, "d80b1a3b-4fc1-49f3-952e-1e2ab7081d8b", s[1].ID)
+	th.AssertEquals(t, "port-2", s[1].Name)
+	th.AssertEquals(t, false, s[1].PortSecurityEnabled)
+}
+
 func TestCreateOmitSecurityGroups(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
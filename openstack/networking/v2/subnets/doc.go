@@ -95,6 +95,26 @@ Example to Create a Subnet With a Default Gateway
 		panic(err)
 	}
 
+Example to Create Several Subnets at Once
+
+	createOpts := []subnets.CreateOptsBuilder{
+		subnets.CreateOpts{
+			NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			IPVersion: 4,
+			CIDR:      "192.168.199.0/24",
+		},
+		subnets.CreateOpts{
+			NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			IPVersion: 6,
+			CIDR:      "fdf8:f53b:82e4::/64",
+		},
+	}
+
+	allSubnets, err := subnets.CreateBulk(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Update a Subnet
 
 	subnetID := "db77d064-e34f-4d06-b060-f21e28a61c23"
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
@@ -34,6 +35,7 @@ type ListOpts struct {
 	IPv6RAMode        string `q:"ipv6_ra_mode"`
 	ID                string `q:"id"`
 	SubnetPoolID      string `q:"subnetpool_id"`
+	SegmentID         string `q:"segment_id"`
 	Limit             int    `q:"limit"`
 	Marker            string `q:"marker"`
 	SortKey           string `q:"sort_key"`
@@ -148,6 +150,10 @@ type CreateOpts struct {
 	// overwrite the "default_prefixlen" value of the referenced subnetpool.
 	Prefixlen int `json:"prefixlen,omitempty"`
 
+	// SegmentID is the ID of the segment of a routed provider network the
+	// subnet is associated with.
+	SegmentID string `json:"segment_id,omitempty"`
+
 	VPCID string `json:"vpc_id,omitempty"`
 }
 
@@ -179,6 +185,32 @@ func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBu
 	return
 }
 
+// CreateBulk creates several subnets in a single request. Extension options,
+// such as those wrapping CreateOpts, may be mixed in opts. Neutron creates
+// the subnets atomically: either all of them are created or none is.
+// The created subnets are returned in the order of opts.
+func CreateBulk(ctx context.Context, c *gophercloud.ServiceClient, opts []CreateOptsBuilder) (r CreateBulkResult) {
+	items := make([]any, len(opts))
+	for i, o := range opts {
+		b, err := o.ToSubnetCreateMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		item, ok := b["subnet"]
+		if !ok {
+			r.Err = fmt.Errorf("subnets.CreateBulk: options at index %d did not build a subnet", i)
+			return
+		}
+		items[i] = item
+	}
+
+	b := map[string]any{"subnets": items}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
@@ -217,6 +249,10 @@ type UpdateOpts struct {
 	// EnableDHCP will either enable to disable the DHCP service.
 	EnableDHCP *bool `json:"enable_dhcp,omitempty"`
 
+	// SegmentID associates the subnet with a segment. Neutron only allows it
+	// to be set on a subnet which is not associated with a segment yet.
+	SegmentID *string `json:"segment_id,omitempty"`
+
 	// RevisionNumber implements extension:standard-attr-revisions. If != "" it
 	// will set revision_number=%s. If the revision number does not match, the
 	// update will fail.
@@ -270,3 +306,15 @@ func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r Del
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// IDFromName is a convenience function that returns a subnet's ID given its
+// name. The lookup is filtered by name server-side and matched exactly client-
+// side.
+//
+// It returns a gophercloud.ErrResourceNotFound if no subnet has that name and a
+// gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	return utils.ResolveID(ctx, List(client, ListOpts{Name: name}), ExtractSubnets, func(s Subnet) (string, string) {
+		return s.Name, s.ID
+	}, "subnet", name)
+}
@@ -24,6 +24,26 @@ type CreateResult struct {
 	commonResult
 }
 
+// CreateBulkResult represents the result of a bulk create operation. Call
+// its Extract method to interpret it as a slice of Subnets.
+type CreateBulkResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the created
+// subnets.
+func (r CreateBulkResult) Extract() ([]Subnet, error) {
+	var s []Subnet
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// ExtractInto extracts the created subnets into v, which should be a pointer
+// to a slice, e.g. of a struct embedding Subnet and extension fields.
+func (r CreateBulkResult) ExtractInto(v any) error {
+	return r.Result.ExtractIntoSlicePtr(v, "subnets")
+}
+
 // GetResult represents the result of a get operation. Call its Extract
 // method to interpret it as a Subnet.
 type GetResult struct {
@@ -116,6 +136,10 @@ type Subnet struct {
 	// SubnetPoolID is the id of the subnet pool associated with the subnet.
 	SubnetPoolID string `json:"subnetpool_id"`
 
+	// SegmentID is the ID of the segment the subnet is associated with, if
+	// any.
+	SegmentID string `json:"segment_id"`
+
 	// Tags optionally set via extensions/attributestags
 	Tags []string `json:"tags"`
 
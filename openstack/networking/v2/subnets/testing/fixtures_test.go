@@ -242,6 +242,66 @@ const SubnetCreateResult = `
 }
 `
 
+const SubnetCreateBulkRequest = `
+{
+	"subnets": [
+		{
+			"network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			"ip_version": 4,
+			"cidr": "192.168.199.0/24"
+		},
+		{
+			"network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			"ip_version": 6,
+			"cidr": "fdf8:f53b:82e4::/64"
+		}
+	]
+}
+`
+
+const SubnetCreateBulkResult = `
+{
+	"subnets": [
+		{
+			"name": "",
+			"enable_dhcp": true,
+			"network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			"tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+			"dns_nameservers": [],
+			"allocation_pools": [
+				{
+					"start": "192.168.199.2",
+					"end": "192.168.199.254"
+				}
+			],
+			"host_routes": [],
+			"ip_version": 4,
+			"gateway_ip": "192.168.199.1",
+			"cidr": "192.168.199.0/24",
+			"id": "3b80198d-4f7b-4f77-9ef5-774d54e17126"
+		},
+		{
+			"name": "",
+			"enable_dhcp": true,
+			"network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			"tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+			"dns_nameservers": [],
+			"allocation_pools": [
+				{
+					"start": "fdf8:f53b:82e4::2",
+					"end": "fdf8:f53b:82e4::ffff:ffff:ffff:ffff"
+				}
+			],
+			"host_routes": [],
+			"ip_version": 6,
+			"gateway_ip": "fdf8:f53b:82e4::1",
+			"cidr": "fdf8:f53b:82e4::/64",
+			"id": "0d1f5a6e-7e43-4c36-a1e9-2d4b7c1c3c3a"
+		}
+	]
+}
+`
+
 const SubnetCreateWithNoGatewayRequest = `
 {
 	"subnet": {
@@ -582,3 +642,46 @@ const SubnetUpdateAllocationPoolResponse = `
 	}
 }
 `
+
+const SubnetCreateWithSegmentRequest = `
+{
+	"subnet": {
+		"network_id": "6227a5d5-8f9b-4a2d-8ac6-9a1d4fcc3e6a",
+		"ip_version": 4,
+		"cidr": "10.2.0.0/24",
+		"segment_id": "053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52"
+	}
+}
+`
+
+const SubnetWithSegmentResponse = `
+{
+	"subnet": {
+		"name": "",
+		"enable_dhcp": true,
+		"network_id": "6227a5d5-8f9b-4a2d-8ac6-9a1d4fcc3e6a",
+		"segment_id": "053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52",
+		"tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+		"dns_nameservers": [],
+		"allocation_pools": [
+			{
+				"start": "10.2.0.2",
+				"end": "10.2.0.254"
+			}
+		],
+		"host_routes": [],
+		"ip_version": 4,
+		"gateway_ip": "10.2.0.1",
+		"cidr": "10.2.0.0/24",
+		"id": "7c1c3c5e-2f4b-4f0a-8f1e-3d2b1a0c9e87"
+	}
+}
+`
+
+const SubnetUpdateSegmentRequest = `
+{
+	"subnet": {
+		"segment_id": "053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52"
+	}
+}
+`
@@ -152,6 +152,54 @@ func TestCreate(t *testing.T) {
 	th.AssertEquals(t, s.SubnetPoolID, "b80340c7-9960-4f67-a99c-02501656284b")
 }
 
+func TestCreateBulk(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, SubnetCreateBulkRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, SubnetCreateBulkResult)
+	})
+
+	opts := []subnets.CreateOptsBuilder{
+		subnets.CreateOpts{
+			NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			IPVersion: 4,
+			CIDR:      "192.168.199.0/24",
+		},
+		subnets.CreateOpts{
+			NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			IPVersion: 6,
+			CIDR:      "fdf8:f53b:82e4::/64",
+		},
+	}
+	s, err := subnets.CreateBulk(context.TODO(), fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(s))
+	th.AssertEquals(t, "3b80198d-4f7b-4f77-9ef5-774d54e17126", s[0].ID)
+	th.AssertEquals(t, 4, s[0].IPVersion)
+	th.AssertEquals(t, "0d1f5a6e-7e43-4c36-a1e9-2d4b7c1c3c3a", s[1].ID)
+	th.AssertEquals(t, "fdf8:f53b:82e4::/64", s[1].CIDR)
+}
+
+func TestCreateBulkInvalidOpts(t *testing.T) {
+	opts := []subnets.CreateOptsBuilder{
+		subnets.CreateOpts{NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22", IPVersion: 4, CIDR: "192.168.199.0/24"},
+		subnets.CreateOpts{IPVersion: 4, CIDR: "192.168.200.0/24"},
+	}
+	err := subnets.CreateBulk(context.TODO(), fake.ServiceClient(), opts).Err
+	th.AssertErr(t, err)
+}
+
 func TestCreateNoGateway(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -673,3 +721,71 @@ func TestDelete(t *testing.T) {
 	res := subnets.Delete(context.TODO(), fake.ServiceClient(), "08eae331-0402-425a-923c-34f7cfe39c1b")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestListBySegment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"segment_id": "053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, SubnetListResult)
+	})
+
+	allPages, err := subnets.List(fake.ServiceClient(), subnets.ListOpts{SegmentID: "053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52"}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+	_, err = subnets.ExtractSubnets(allPages)
+	th.AssertNoErr(t, err)
+}
+
+func TestCreateWithSegment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, SubnetCreateWithSegmentRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, SubnetWithSegmentResponse)
+	})
+
+	opts := subnets.CreateOpts{
+		NetworkID: "6227a5d5-8f9b-4a2d-8ac6-9a1d4fcc3e6a",
+		IPVersion: 4,
+		CIDR:      "10.2.0.0/24",
+		SegmentID: "053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52",
+	}
+	s, err := subnets.Create(context.TODO(), fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52", s.SegmentID)
+}
+
+func TestUpdateSegment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnets/7c1c3c5e-2f4b-4f0a-8f1e-3d2b1a0c9e87", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, SubnetUpdateSegmentRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, SubnetWithSegmentResponse)
+	})
+
+	segmentID := "053c0c3c-84b7-4ba6-99a5-1e8e8e0b2f52"
+	s, err := subnets.Update(context.TODO(), fake.ServiceClient(), "7c1c3c5e-2f4b-4f0a-8f1e-3d2b1a0c9e87", subnets.UpdateOpts{SegmentID: &segmentID}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, segmentID, s.SegmentID)
+}
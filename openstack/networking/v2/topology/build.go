@@ -0,0 +1,180 @@
+package topology
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/external"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/trunks"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/peeringconnections"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/subnets"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/vpcs"
+)
+
+// NetworkWithExternal is a network along with its router:external attribute.
+type NetworkWithExternal struct {
+	networks.Network
+	external.NetworkExternalExt
+}
+
+// Resources holds the resources a Graph is built from.
+type Resources struct {
+	VPCs               []vpcs.VPC
+	PeeringConnections []peeringconnections.PeeringConnection
+	Networks           []NetworkWithExternal
+	Subnets            []subnets.Subnet
+	Routers            []routers.Router
+	Ports              []ports.Port
+	FloatingIPs        []floatingips.FloatingIP
+	Trunks             []trunks.Trunk
+	Servers            []servers.Server
+	LoadBalancers      []loadbalancers.LoadBalancer
+}
+
+// hiddenDeviceOwners are the owners of ports which Neutron creates to
+// implement another resource. The connection they make is represented by an
+// edge between the resources themselves, e.g. a router and its gateway
+// network.
+var hiddenDeviceOwners = map[string]bool{
+	"network:router_gateway": true,
+	"network:floatingip":     true,
+	"network:dhcp":           true,
+}
+
+// New builds a graph from res. Resources referred to but not part of res,
+// such as an external network owned by another project, are added as
+// placeholder nodes.
+func New(res Resources) *Graph {
+	g := &Graph{}
+
+	for _, v := range res.VPCs {
+		g.AddNode(Node{Type: VPC, ID: v.ID, Name: v.Name, Status: v.Status, Attributes: attributes("cidr", v.CIDR)})
+	}
+
+	for _, n := range res.Networks {
+		var attrs map[string]string
+		if n.External {
+			attrs = attributes("external", "true")
+		}
+		g.AddNode(Node{Type: Network, ID: n.ID, Name: n.Name, Status: n.Status, Attributes: attrs})
+	}
+
+	for _, s := range res.Subnets {
+		g.AddNode(Node{Type: Subnet, ID: s.ID, Name: s.Name, Attributes: attributes("cidr", s.CIDR, "gateway_ip", s.GatewayIP)})
+		g.AddEdge(Edge{From: Ref{Subnet, s.ID}, To: Ref{Network, s.NetworkID}, Type: EdgeInNetwork})
+		if s.VPCID != "" {
+			g.AddEdge(Edge{From: Ref{Subnet, s.ID}, To: Ref{VPC, s.VPCID}, Type: EdgeInVPC})
+		}
+	}
+
+	for _, r := range res.Routers {
+		g.AddNode(Node{Type: Router, ID: r.ID, Name: r.Name, Status: r.Status})
+		if r.GatewayInfo.NetworkID != "" {
+			var ips []string
+			for _, ip := range r.GatewayInfo.ExternalFixedIPs {
+				ips = append(ips, ip.IPAddress)
+			}
+			g.AddEdge(Edge{
+				From:       Ref{Router, r.ID},
+				To:         Ref{Network, r.GatewayInfo.NetworkID},
+				Type:       EdgeRouterGateway,
+				Attributes: attributes("ip_addresses", strings.Join(ips, ",")),
+			})
+		}
+	}
+
+	for _, p := range res.Ports {
+		if hiddenDeviceOwners[p.DeviceOwner] {
+			continue
+		}
+
+		g.AddNode(Node{Type: Port, ID: p.ID, Name: p.Name, Status: p.Status, Attributes: attributes("mac_address", p.MACAddress, "device_owner", p.DeviceOwner)})
+		g.AddEdge(Edge{From: Ref{Port, p.ID}, To: Ref{Network, p.NetworkID}, Type: EdgeInNetwork})
+		for _, ip := range p.FixedIPs {
+			g.AddEdge(Edge{From: Ref{Port, p.ID}, To: Ref{Subnet, ip.SubnetID}, Type: EdgeInSubnet, Attributes: attributes("ip_address", ip.IPAddress)})
+		}
+
+		if p.DeviceID == "" {
+			continue
+		}
+		switch {
+		case p.IsRouterInterface():
+			g.AddEdge(Edge{From: Ref{Router, p.DeviceID}, To: Ref{Port, p.ID}, Type: EdgeRouterInterface})
+		case strings.HasPrefix(p.DeviceOwner, "compute:"):
+			g.AddEdge(Edge{From: Ref{Server, p.DeviceID}, To: Ref{Port, p.ID}, Type: EdgeServerPort})
+		}
+	}
+
+	for _, fip := range res.FloatingIPs {
+		g.AddNode(Node{Type: FloatingIP, ID: fip.ID, Name: fip.FloatingIP, Status: fip.Status})
+		if fip.FloatingNetworkID != "" {
+			g.AddEdge(Edge{From: Ref{FloatingIP, fip.ID}, To: Ref{Network, fip.FloatingNetworkID}, Type: EdgeFloatingIPNetwork})
+		}
+		if fip.PortID != "" {
+			g.AddEdge(Edge{From: Ref{FloatingIP, fip.ID}, To: Ref{Port, fip.PortID}, Type: EdgeFloatingIPPort, Attributes: attributes("fixed_ip_address", fip.FixedIP)})
+		}
+	}
+
+	for _, t := range res.Trunks {
+		g.AddNode(Node{Type: Trunk, ID: t.ID, Name: t.Name, Status: t.Status})
+		g.AddEdge(Edge{From: Ref{Trunk, t.ID}, To: Ref{Port, t.PortID}, Type: EdgeTrunkParent})
+		for _, sp := range t.Subports {
+			g.AddEdge(Edge{
+				From:       Ref{Trunk, t.ID},
+				To:         Ref{Port, sp.PortID},
+				Type:       EdgeTrunkSubport,
+				Attributes: attributes("segmentation_type", sp.SegmentationType, "segmentation_id", strconv.Itoa(sp.SegmentationID)),
+			})
+		}
+	}
+
+	for _, s := range res.Servers {
+		g.AddNode(Node{Type: Server, ID: s.ID, Name: s.Name, Status: s.Status})
+	}
+
+	for _, lb := range res.LoadBalancers {
+		g.AddNode(Node{Type: LoadBalancer, ID: lb.ID, Name: lb.Name, Status: lb.ProvisioningStatus, Attributes: attributes("vip_address", lb.VipAddress)})
+		switch {
+		case lb.VipPortID != "":
+			g.AddEdge(Edge{From: Ref{LoadBalancer, lb.ID}, To: Ref{Port, lb.VipPortID}, Type: EdgeLoadBalancerVIP})
+		case lb.VipSubnetID != "":
+			g.AddEdge(Edge{From: Ref{LoadBalancer, lb.ID}, To: Ref{Subnet, lb.VipSubnetID}, Type: EdgeInSubnet})
+		}
+	}
+
+	for _, pc := range res.PeeringConnections {
+		if pc.VpcId == "" || pc.PeerVpcId == "" {
+			continue
+		}
+		g.AddEdge(Edge{
+			From:       Ref{VPC, pc.VpcId},
+			To:         Ref{VPC, pc.PeerVpcId},
+			Type:       EdgePeering,
+			Attributes: attributes("id", pc.ID, "status", pc.Status, "peering_status", pc.PeerStatus),
+		})
+	}
+
+	return g
+}
+
+// attributes builds an attribute map from key/value pairs, leaving out empty
+// values. It returns nil when every value is empty.
+func attributes(kv ...string) map[string]string {
+	var m map[string]string
+	for i := 0; i+1 < len(kv); i += 2 {
+		if kv[i+1] == "" {
+			continue
+		}
+		if m == nil {
+			m = make(map[string]string)
+		}
+		m[kv[i]] = kv[i+1]
+	}
+	return m
+}
@@ -0,0 +1,175 @@
+package topology
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/external"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/trunks"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/peeringconnections"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/subnets"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/vpcs"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// Clients holds the service clients used to collect resources. Network is
+// required; servers and load balancers are only collected when their client
+// is set.
+type Clients struct {
+	Network      *gophercloud.ServiceClient
+	Compute      *gophercloud.ServiceClient
+	LoadBalancer *gophercloud.ServiceClient
+}
+
+// Opts configures Collect and Build.
+type Opts struct {
+	// ProjectID restricts the collected resources to a project. External
+	// networks are always collected, and peering connections are kept when
+	// one of their VPCs belongs to the project. When empty, every resource
+	// visible to the clients is collected.
+	ProjectID string
+}
+
+// Build collects the resources of the project and builds their graph.
+func Build(ctx context.Context, clients Clients, opts Opts) (*Graph, error) {
+	res, err := Collect(ctx, clients, opts)
+	if err != nil {
+		return nil, err
+	}
+	return New(*res), nil
+}
+
+// Collect lists the resources of the project, in parallel.
+func Collect(ctx context.Context, clients Clients, opts Opts) (*Resources, error) {
+	if clients.Network == nil {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "topology.Clients.Network"
+		return nil, err
+	}
+
+	var (
+		res          Resources
+		externalNets []NetworkWithExternal
+		peerings     []peeringconnections.PeeringConnection
+
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		errs []error
+	)
+
+	run := func(name string, list func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := list(); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("unable to list %s: %w", name, err))
+				mu.Unlock()
+			}
+		}()
+	}
+
+	nc := clients.Network
+	projectID := opts.ProjectID
+
+	run("VPCs", func() (err error) {
+		res.VPCs, err = utils.Collect(ctx, vpcs.List(nc, vpcs.ListOpts{ProjectID: projectID}), vpcs.ExtractVPCs)
+		return
+	})
+	run("peering connections", func() (err error) {
+		peerings, err = utils.Collect(ctx, peeringconnections.List(nc, nil), peeringconnections.ExtractPeeringConnections)
+		return
+	})
+	run("networks", func() (err error) {
+		res.Networks, err = utils.Collect(ctx, networks.List(nc, networks.ListOpts{ProjectID: projectID}), extractNetworks)
+		return
+	})
+	if projectID != "" {
+		run("external networks", func() (err error) {
+			iTrue := true
+			externalNets, err = utils.Collect(ctx, networks.List(nc, external.ListOptsExt{ListOptsBuilder: networks.ListOpts{}, External: &iTrue}), extractNetworks)
+			return
+		})
+	}
+	run("subnets", func() (err error) {
+		res.Subnets, err = utils.Collect(ctx, subnets.List(nc, subnets.ListOpts{ProjectID: projectID}), subnets.ExtractSubnets)
+		return
+	})
+	run("routers", func() (err error) {
+		res.Routers, err = utils.Collect(ctx, routers.List(nc, routers.ListOpts{ProjectID: projectID}), routers.ExtractRouters)
+		return
+	})
+	run("ports", func() (err error) {
+		res.Ports, err = utils.Collect(ctx, ports.List(nc, ports.ListOpts{ProjectID: projectID}), ports.ExtractPorts)
+		return
+	})
+	run("floating IPs", func() (err error) {
+		res.FloatingIPs, err = utils.Collect(ctx, floatingips.List(nc, floatingips.ListOpts{ProjectID: projectID}), floatingips.ExtractFloatingIPs)
+		return
+	})
+	run("trunks", func() (err error) {
+		res.Trunks, err = utils.Collect(ctx, trunks.List(nc, trunks.ListOpts{ProjectID: projectID}), trunks.ExtractTrunks)
+		return
+	})
+	if clients.Compute != nil {
+		run("servers", func() (err error) {
+			listOpts := servers.ListOpts{}
+			if projectID != "" {
+				listOpts.AllTenants = true
+				listOpts.TenantID = projectID
+			}
+			res.Servers, err = utils.Collect(ctx, servers.List(clients.Compute, listOpts), servers.ExtractServers)
+			return
+		})
+	}
+	if clients.LoadBalancer != nil {
+		run("load balancers", func() (err error) {
+			res.LoadBalancers, err = utils.Collect(ctx, loadbalancers.List(clients.LoadBalancer, loadbalancers.ListOpts{ProjectID: projectID}), loadbalancers.ExtractLoadBalancers)
+			return
+		})
+	}
+
+	wg.Wait()
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	seen := make(map[string]bool, len(res.Networks))
+	for _, n := range res.Networks {
+		seen[n.ID] = true
+	}
+	for _, n := range externalNets {
+		if !seen[n.ID] {
+			res.Networks = append(res.Networks, n)
+		}
+	}
+
+	// Peering connections carry no project; keep those which involve one
+	// of the collected VPCs.
+	owned := make(map[string]bool, len(res.VPCs))
+	for _, v := range res.VPCs {
+		owned[v.ID] = true
+	}
+	for _, pc := range peerings {
+		if projectID == "" || owned[pc.VpcId] || owned[pc.PeerVpcId] {
+			res.PeeringConnections = append(res.PeeringConnections, pc)
+		}
+	}
+
+	return &res, nil
+}
+
+func extractNetworks(page pagination.Page) ([]NetworkWithExternal, error) {
+	var s []NetworkWithExternal
+	err := networks.ExtractNetworksInto(page, &s)
+	return s, err
+}
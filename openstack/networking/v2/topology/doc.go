@@ -0,0 +1,58 @@
+/*
+Package topology builds an in-memory graph of how the network resources of a
+project connect: VPCs, networks, subnets, routers and their interfaces,
+ports, floating IPs, trunks, servers and load balancers.
+
+Resources are collected in parallel with the List functions of each service.
+Nodes are resources and edges are the references between them, e.g. from a
+port to the subnets it has an address in, from a router to its gateway
+network, or between the two VPCs of a peering connection. Resources which are
+referred to but not collected, such as the external network of another
+project, appear as placeholder nodes.
+
+Example to Find What Connects a Server to an External Network
+
+	g, err := topology.Build(context.TODO(), topology.Clients{
+		Network: networkClient,
+		Compute: computeClient,
+	}, topology.Opts{
+		ProjectID: "b9b4d5ad5d0d47e5b4b4c07f3b6f27d5",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	server := topology.Ref{Type: topology.Server, ID: "9e5476bd-a4ec-4653-93d6-72c93aa682ba"}
+	public := g.Find(topology.Network, "public")[0].Ref()
+
+	path, err := g.Path(server, public)
+	if err != nil {
+		panic(err)
+	}
+
+	for i, n := range path.Nodes {
+		fmt.Printf("%s %s (%s)\n", n.Type, n.Name, n.ID)
+		if i < len(path.Edges) {
+			fmt.Printf("  %s\n", path.Edges[i].Type)
+		}
+	}
+
+Example to Export a Graph to Graphviz
+
+	f, err := os.Create("topology.dot")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	if err := g.WriteDOT(f); err != nil {
+		panic(err)
+	}
+
+Example to Export a Graph to JSON
+
+	if err := g.WriteJSON(os.Stdout); err != nil {
+		panic(err)
+	}
+*/
+package topology
@@ -0,0 +1,106 @@
+package topology
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// jsonGraph is the JSON representation of a Graph.
+type jsonGraph struct {
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+}
+
+// MarshalJSON encodes the graph as an object holding its nodes and edges.
+func (g *Graph) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonGraph{Nodes: g.Nodes(), Edges: g.Edges()})
+}
+
+// UnmarshalJSON decodes a graph encoded by MarshalJSON.
+func (g *Graph) UnmarshalJSON(b []byte) error {
+	var s jsonGraph
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*g = Graph{}
+	for _, n := range s.Nodes {
+		g.AddNode(n)
+	}
+	for _, e := range s.Edges {
+		g.AddEdge(e)
+	}
+	return nil
+}
+
+// WriteJSON writes the graph to w as indented JSON.
+func (g *Graph) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(g)
+}
+
+// dotShapes are the Graphviz shapes of each node type.
+var dotShapes = map[NodeType]string{
+	VPC:          "box3d",
+	Network:      "box",
+	Subnet:       "box",
+	Router:       "diamond",
+	Port:         "circle",
+	FloatingIP:   "doublecircle",
+	Trunk:        "hexagon",
+	Server:       "component",
+	LoadBalancer: "invtrapezium",
+}
+
+// WriteDOT writes the graph to w in the Graphviz DOT language. Nodes are
+// labelled with their name and type, and edges with their type. Placeholder
+// nodes are drawn dashed.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "graph topology {")
+	fmt.Fprintln(bw, "\tnode [fontsize=10];")
+	fmt.Fprintln(bw, "\tedge [fontsize=8];")
+
+	for _, n := range g.Nodes() {
+		name := n.Name
+		if name == "" {
+			name = n.ID
+		}
+		label := name + "\n" + string(n.Type)
+		if cidr := n.Attributes["cidr"]; cidr != "" {
+			label += "\n" + cidr
+		}
+
+		attrs := []string{
+			"label=" + dotQuote(label),
+			"shape=" + dotShapes[n.Type],
+		}
+		if n.Placeholder {
+			attrs = append(attrs, "style=dashed")
+		}
+		fmt.Fprintf(bw, "\t%s [%s];\n", dotQuote(n.Ref().String()), strings.Join(attrs, ", "))
+	}
+
+	for _, e := range g.Edges() {
+		label := string(e.Type)
+		for _, k := range []string{"ip_address", "fixed_ip_address"} {
+			if v := e.Attributes[k]; v != "" {
+				label += "\n" + v
+			}
+		}
+		fmt.Fprintf(bw, "\t%s -- %s [label=%s];\n", dotQuote(e.From.String()), dotQuote(e.To.String()), dotQuote(label))
+	}
+
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// dotQuote quotes s as a DOT string.
+func dotQuote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
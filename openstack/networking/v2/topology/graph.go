@@ -0,0 +1,365 @@
+package topology
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+)
+
+// NodeType identifies the kind of resource a Node stands for.
+type NodeType string
+
+const (
+	VPC          NodeType = "vpc"
+	Network      NodeType = "network"
+	Subnet       NodeType = "subnet"
+	Router       NodeType = "router"
+	Port         NodeType = "port"
+	FloatingIP   NodeType = "floating_ip"
+	Trunk        NodeType = "trunk"
+	Server       NodeType = "server"
+	LoadBalancer NodeType = "load_balancer"
+)
+
+// nodeOrder is the order in which node types are listed and exported.
+var nodeOrder = []NodeType{VPC, Network, Subnet, Router, Port, FloatingIP, Trunk, Server, LoadBalancer}
+
+// EdgeType describes how two nodes are connected.
+type EdgeType string
+
+const (
+	// EdgeInVPC links a subnet to its VPC.
+	EdgeInVPC EdgeType = "in_vpc"
+
+	// EdgeInNetwork links a subnet or a port to its network.
+	EdgeInNetwork EdgeType = "in_network"
+
+	// EdgeInSubnet links a port to a subnet it has a fixed IP in.
+	EdgeInSubnet EdgeType = "in_subnet"
+
+	// EdgeRouterInterface links a router to one of its interface ports.
+	EdgeRouterInterface EdgeType = "router_interface"
+
+	// EdgeRouterGateway links a router to its external gateway network.
+	EdgeRouterGateway EdgeType = "router_gateway"
+
+	// EdgeFloatingIPPort links a floating IP to the port it is associated
+	// with.
+	EdgeFloatingIPPort EdgeType = "floating_ip_port"
+
+	// EdgeFloatingIPNetwork links a floating IP to the external network it
+	// was allocated from.
+	EdgeFloatingIPNetwork EdgeType = "floating_ip_network"
+
+	// EdgeTrunkParent links a trunk to its parent port.
+	EdgeTrunkParent EdgeType = "trunk_parent"
+
+	// EdgeTrunkSubport links a trunk to one of its subports.
+	EdgeTrunkSubport EdgeType = "trunk_subport"
+
+	// EdgeServerPort links a server to a port attached to it.
+	EdgeServerPort EdgeType = "server_port"
+
+	// EdgeLoadBalancerVIP links a load balancer to its VIP port.
+	EdgeLoadBalancerVIP EdgeType = "load_balancer_vip"
+
+	// EdgePeering links the two VPCs of a peering connection.
+	EdgePeering EdgeType = "peering"
+)
+
+// Ref identifies a node of the graph.
+type Ref struct {
+	Type NodeType `json:"type"`
+	ID   string   `json:"id"`
+}
+
+func (r Ref) String() string {
+	return string(r.Type) + ":" + r.ID
+}
+
+// Node is a resource of the graph.
+type Node struct {
+	Type   NodeType `json:"type"`
+	ID     string   `json:"id"`
+	Name   string   `json:"name,omitempty"`
+	Status string   `json:"status,omitempty"`
+
+	// Attributes holds resource specific details, such as the CIDR of a
+	// subnet or the MAC address of a port.
+	Attributes map[string]string `json:"attributes,omitempty"`
+
+	// Placeholder is true for resources which are referred to by collected
+	// resources but were not collected themselves, e.g. the external network
+	// of another project.
+	Placeholder bool `json:"placeholder,omitempty"`
+}
+
+// Ref returns the reference of the node.
+func (n Node) Ref() Ref {
+	return Ref{Type: n.Type, ID: n.ID}
+}
+
+// Edge is a connection between two nodes. Edges are directed from the
+// dependent resource to the resource it is attached to, e.g. from a port to
+// its network, but queries follow them in both directions.
+type Edge struct {
+	From Ref      `json:"from"`
+	To   Ref      `json:"to"`
+	Type EdgeType `json:"type"`
+
+	// Attributes holds connection specific details, such as the IP address
+	// of a port in a subnet.
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// ErrNoPath is returned by Graph.Path when two nodes are not connected.
+type ErrNoPath struct {
+	gophercloud.BaseError
+	From Ref
+	To   Ref
+}
+
+func (e ErrNoPath) Error() string {
+	return fmt.Sprintf("No path from %s to %s", e.From, e.To)
+}
+
+// ErrNodeNotFound is returned by Graph.Path when a node is not in the graph.
+type ErrNodeNotFound struct {
+	gophercloud.BaseError
+	Ref Ref
+}
+
+func (e ErrNodeNotFound) Error() string {
+	return fmt.Sprintf("Node %s not found", e.Ref)
+}
+
+// Graph is an in-memory graph of network resources. The zero value is an
+// empty graph ready to use.
+type Graph struct {
+	nodes map[Ref]*Node
+	edges []Edge
+
+	// adjacency maps a node to the indexes of its edges in both directions.
+	adjacency map[Ref][]int
+}
+
+// AddNode adds n to the graph. If the node already exists, the fields of n
+// which are set replace those of the existing node.
+func (g *Graph) AddNode(n Node) {
+	if g.nodes == nil {
+		g.nodes = make(map[Ref]*Node)
+		g.adjacency = make(map[Ref][]int)
+	}
+
+	existing, ok := g.nodes[n.Ref()]
+	if !ok || existing.Placeholder {
+		g.nodes[n.Ref()] = &n
+		return
+	}
+	if n.Name != "" {
+		existing.Name = n.Name
+	}
+	if n.Status != "" {
+		existing.Status = n.Status
+	}
+	for k, v := range n.Attributes {
+		if existing.Attributes == nil {
+			existing.Attributes = make(map[string]string)
+		}
+		existing.Attributes[k] = v
+	}
+}
+
+// AddEdge adds e to the graph. Endpoints which are not in the graph yet are
+// added as placeholders. An edge identical in endpoints and type to an
+// existing one is ignored.
+func (g *Graph) AddEdge(e Edge) {
+	for _, ref := range []Ref{e.From, e.To} {
+		if _, ok := g.nodes[ref]; !ok {
+			g.AddNode(Node{Type: ref.Type, ID: ref.ID, Placeholder: true})
+		}
+	}
+
+	for _, i := range g.adjacency[e.From] {
+		if o := g.edges[i]; o.From == e.From && o.To == e.To && o.Type == e.Type {
+			return
+		}
+	}
+
+	i := len(g.edges)
+	g.edges = append(g.edges, e)
+	g.adjacency[e.From] = append(g.adjacency[e.From], i)
+	if e.To != e.From {
+		g.adjacency[e.To] = append(g.adjacency[e.To], i)
+	}
+}
+
+// Node returns the node identified by ref.
+func (g *Graph) Node(ref Ref) (Node, bool) {
+	n, ok := g.nodes[ref]
+	if !ok {
+		return Node{}, false
+	}
+	return *n, true
+}
+
+// Nodes returns every node of the graph, ordered by type and ID.
+func (g *Graph) Nodes() []Node {
+	nodes := make([]Node, 0, len(g.nodes))
+	for _, n := range g.nodes {
+		nodes = append(nodes, *n)
+	}
+	slices.SortFunc(nodes, func(a, b Node) int { return compareRefs(a.Ref(), b.Ref()) })
+	return nodes
+}
+
+// NodesOfType returns the nodes of type t, ordered by ID.
+func (g *Graph) NodesOfType(t NodeType) []Node {
+	var nodes []Node
+	for _, n := range g.Nodes() {
+		if n.Type == t {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// Find returns the nodes of type t whose ID or name is nameOrID.
+func (g *Graph) Find(t NodeType, nameOrID string) []Node {
+	var nodes []Node
+	for _, n := range g.NodesOfType(t) {
+		if n.ID == nameOrID || n.Name == nameOrID {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// Edges returns every edge of the graph, ordered by their endpoints and type.
+func (g *Graph) Edges() []Edge {
+	edges := slices.Clone(g.edges)
+	slices.SortFunc(edges, compareEdges)
+	return edges
+}
+
+// EdgesOf returns the edges from or to ref.
+func (g *Graph) EdgesOf(ref Ref) []Edge {
+	edges := make([]Edge, 0, len(g.adjacency[ref]))
+	for _, i := range g.adjacency[ref] {
+		edges = append(edges, g.edges[i])
+	}
+	slices.SortFunc(edges, compareEdges)
+	return edges
+}
+
+// Neighbors returns the nodes connected to ref by an edge in either
+// direction, ordered by type and ID.
+func (g *Graph) Neighbors(ref Ref) []Node {
+	seen := make(map[Ref]bool)
+	var nodes []Node
+	for _, e := range g.EdgesOf(ref) {
+		other := e.To
+		if other == ref {
+			other = e.From
+		}
+		if !seen[other] {
+			seen[other] = true
+			nodes = append(nodes, *g.nodes[other])
+		}
+	}
+	slices.SortFunc(nodes, func(a, b Node) int { return compareRefs(a.Ref(), b.Ref()) })
+	return nodes
+}
+
+// Path is a sequence of nodes and the edges between them. Edges[i] connects
+// Nodes[i] and Nodes[i+1].
+type Path struct {
+	Nodes []Node
+	Edges []Edge
+}
+
+// Path returns a shortest path between from and to, following edges in
+// either direction, e.g. to find out what connects a server to an external
+// network. An ErrNoPath is returned if the nodes are not connected.
+func (g *Graph) Path(from, to Ref) (Path, error) {
+	for _, ref := range []Ref{from, to} {
+		if _, ok := g.nodes[ref]; !ok {
+			return Path{}, ErrNodeNotFound{Ref: ref}
+		}
+	}
+
+	// Breadth-first search, visiting edges in a stable order so that the
+	// same path is returned for the same graph.
+	via := map[Ref]int{from: -1}
+	queue := []Ref{from}
+	for len(queue) > 0 && !hasKey(via, to) {
+		ref := queue[0]
+		queue = queue[1:]
+
+		for _, i := range g.sortedAdjacency(ref) {
+			e := g.edges[i]
+			next := e.To
+			if next == ref {
+				next = e.From
+			}
+			if hasKey(via, next) {
+				continue
+			}
+			via[next] = i
+			queue = append(queue, next)
+		}
+	}
+
+	if !hasKey(via, to) {
+		return Path{}, ErrNoPath{From: from, To: to}
+	}
+
+	var p Path
+	for ref := to; ; {
+		p.Nodes = append(p.Nodes, *g.nodes[ref])
+		i := via[ref]
+		if i < 0 {
+			break
+		}
+		e := g.edges[i]
+		p.Edges = append(p.Edges, e)
+		if e.To == ref {
+			ref = e.From
+		} else {
+			ref = e.To
+		}
+	}
+	slices.Reverse(p.Nodes)
+	slices.Reverse(p.Edges)
+	return p, nil
+}
+
+func (g *Graph) sortedAdjacency(ref Ref) []int {
+	indexes := slices.Clone(g.adjacency[ref])
+	slices.SortFunc(indexes, func(a, b int) int { return compareEdges(g.edges[a], g.edges[b]) })
+	return indexes
+}
+
+func hasKey[K comparable, V any](m map[K]V, k K) bool {
+	_, ok := m[k]
+	return ok
+}
+
+func compareRefs(a, b Ref) int {
+	if c := cmp.Compare(slices.Index(nodeOrder, a.Type), slices.Index(nodeOrder, b.Type)); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.ID, b.ID)
+}
+
+func compareEdges(a, b Edge) int {
+	if c := compareRefs(a.From, b.From); c != 0 {
+		return c
+	}
+	if c := compareRefs(a.To, b.To); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.Type, b.Type)
+}
@@ -0,0 +1,2 @@
+// Package testing includes topology unit tests
+package testing
@@ -0,0 +1,213 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+const (
+	projectID     = "b9b4d5ad5d0d47e5b4b4c07f3b6f27d5"
+	vpcID         = "5b6e4c0e-6f3a-4c0b-8a5a-0f9e4b7c2d11"
+	peerVPCID     = "e2d4a6b8-1c3e-4f5a-8b7c-9d0e1f2a3b4c"
+	privateNetID  = "db193ab3-96e3-4cb3-8fc5-05f4296d0324"
+	publicNetID   = "0f0e8a5e-2a1c-4b6f-9c1a-3c1f5b0e2d7a"
+	subnetID      = "08eae331-0402-425a-923c-34f7cfe39c1b"
+	routerID      = "f8a44de0-fc8e-45df-93c7-f79bf3b01c95"
+	interfacePort = "2f5c4d1d-7a8b-4e0b-a7d4-46f2d1b3a5a1"
+	serverPort    = "b8a3f0c1-49a2-4bd5-9a8f-4ac5cb3c1e58"
+	subPort       = "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f"
+	serverID      = "9e5476bd-a4ec-4653-93d6-72c93aa682ba"
+	floatingIPID  = "2f245a7b-796b-4f26-9cf9-9e82d248fda7"
+	trunkID       = "f6a9718c-5a64-43e3-944f-4deccad8e78c"
+	lbID          = "36e08a3e-a78f-4b40-a229-1e7e23eee1ab"
+	lbPort        = "5a8e9b1c-2d3f-4a6b-8c7d-9e0f1a2b3c4d"
+)
+
+const ListVPCsResponse = `
+{
+    "vpcs": [
+        {"id": "5b6e4c0e-6f3a-4c0b-8a5a-0f9e4b7c2d11", "name": "vpc1", "cidr": "10.0.0.0/16", "status": "ACTIVE"}
+    ]
+}
+`
+
+const ListPeeringConnectionsResponse = `
+{
+    "peering_connections": [
+        {
+            "id": "3c7f1a52-5e3b-4c6a-9b1f-0d3e6f1a2b44",
+            "description": "to-shared",
+            "status": "ACTIVE",
+            "src_vpc_id": "5b6e4c0e-6f3a-4c0b-8a5a-0f9e4b7c2d11",
+            "dest_vpc_id": "e2d4a6b8-1c3e-4f5a-8b7c-9d0e1f2a3b4c"
+        },
+        {
+            "id": "7d8e9f0a-1b2c-4d3e-8f4a-5b6c7d8e9f0a",
+            "description": "unrelated",
+            "src_vpc_id": "11111111-2222-4333-8444-555555555555",
+            "dest_vpc_id": "e2d4a6b8-1c3e-4f5a-8b7c-9d0e1f2a3b4c"
+        }
+    ]
+}
+`
+
+const ListNetworksResponse = `
+{
+    "networks": [
+        {"id": "db193ab3-96e3-4cb3-8fc5-05f4296d0324", "name": "private", "status": "ACTIVE", "router:external": false}
+    ]
+}
+`
+
+const ListExternalNetworksResponse = `
+{
+    "networks": [
+        {"id": "0f0e8a5e-2a1c-4b6f-9c1a-3c1f5b0e2d7a", "name": "public", "status": "ACTIVE", "router:external": true}
+    ]
+}
+`
+
+const ListSubnetsResponse = `
+{
+    "subnets": [
+        {
+            "id": "08eae331-0402-425a-923c-34f7cfe39c1b",
+            "name": "subnet1",
+            "network_id": "db193ab3-96e3-4cb3-8fc5-05f4296d0324",
+            "vpc_id": "5b6e4c0e-6f3a-4c0b-8a5a-0f9e4b7c2d11",
+            "cidr": "10.0.0.0/24",
+            "gateway_ip": "10.0.0.1"
+        }
+    ]
+}
+`
+
+const ListRoutersResponse = `
+{
+    "routers": [
+        {
+            "id": "f8a44de0-fc8e-45df-93c7-f79bf3b01c95",
+            "name": "router1",
+            "status": "ACTIVE",
+            "external_gateway_info": {
+                "network_id": "0f0e8a5e-2a1c-4b6f-9c1a-3c1f5b0e2d7a",
+                "external_fixed_ips": [{"subnet_id": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d", "ip_address": "203.0.113.10"}]
+            }
+        }
+    ]
+}
+`
+
+const ListPortsResponse = `
+{
+    "ports": [
+        {
+            "id": "2f5c4d1d-7a8b-4e0b-a7d4-46f2d1b3a5a1",
+            "network_id": "db193ab3-96e3-4cb3-8fc5-05f4296d0324",
+            "device_owner": "network:router_interface",
+            "device_id": "f8a44de0-fc8e-45df-93c7-f79bf3b01c95",
+            "fixed_ips": [{"subnet_id": "08eae331-0402-425a-923c-34f7cfe39c1b", "ip_address": "10.0.0.1"}]
+        },
+        {
+            "id": "b8a3f0c1-49a2-4bd5-9a8f-4ac5cb3c1e58",
+            "name": "app-port",
+            "network_id": "db193ab3-96e3-4cb3-8fc5-05f4296d0324",
+            "device_owner": "compute:nova",
+            "device_id": "9e5476bd-a4ec-4653-93d6-72c93aa682ba",
+            "mac_address": "fa:16:3e:c9:cb:f0",
+            "fixed_ips": [{"subnet_id": "08eae331-0402-425a-923c-34f7cfe39c1b", "ip_address": "10.0.0.5"}]
+        },
+        {
+            "id": "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f",
+            "name": "vlan-100",
+            "network_id": "db193ab3-96e3-4cb3-8fc5-05f4296d0324",
+            "device_owner": "trunk:subport",
+            "device_id": "9e5476bd-a4ec-4653-93d6-72c93aa682ba",
+            "fixed_ips": []
+        },
+        {
+            "id": "9b8a7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d",
+            "network_id": "db193ab3-96e3-4cb3-8fc5-05f4296d0324",
+            "device_owner": "network:dhcp",
+            "device_id": "dhcp1",
+            "fixed_ips": [{"subnet_id": "08eae331-0402-425a-923c-34f7cfe39c1b", "ip_address": "10.0.0.2"}]
+        }
+    ]
+}
+`
+
+const ListFloatingIPsResponse = `
+{
+    "floatingips": [
+        {
+            "id": "2f245a7b-796b-4f26-9cf9-9e82d248fda7",
+            "floating_network_id": "0f0e8a5e-2a1c-4b6f-9c1a-3c1f5b0e2d7a",
+            "floating_ip_address": "203.0.113.20",
+            "port_id": "b8a3f0c1-49a2-4bd5-9a8f-4ac5cb3c1e58",
+            "fixed_ip_address": "10.0.0.5",
+            "status": "ACTIVE"
+        }
+    ]
+}
+`
+
+const ListTrunksResponse = `
+{
+    "trunks": [
+        {
+            "id": "f6a9718c-5a64-43e3-944f-4deccad8e78c",
+            "name": "trunk1",
+            "port_id": "b8a3f0c1-49a2-4bd5-9a8f-4ac5cb3c1e58",
+            "status": "ACTIVE",
+            "sub_ports": [
+                {"port_id": "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f", "segmentation_type": "vlan", "segmentation_id": 100}
+            ]
+        }
+    ]
+}
+`
+
+// HandleNetworkResources serves the List requests of every network
+// resource collected by topology.Collect.
+func HandleNetworkResources(t *testing.T) {
+	handle := func(path, response string, query map[string]string) {
+		th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			if query != nil {
+				th.TestFormValues(t, r, query)
+			}
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, response)
+		})
+	}
+
+	project := map[string]string{"project_id": projectID}
+	handle("/v2.0/vpcs", ListVPCsResponse, project)
+	handle("/v2.0/peering-connections", ListPeeringConnectionsResponse, nil)
+	handle("/v2.0/subnets", ListSubnetsResponse, project)
+	handle("/v2.0/routers", ListRoutersResponse, project)
+	handle("/v2.0/ports", ListPortsResponse, project)
+	handle("/v2.0/floatingips", ListFloatingIPsResponse, project)
+	handle("/v2.0/trunks", ListTrunksResponse, project)
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("router:external") == "true" {
+			fmt.Fprint(w, ListExternalNetworksResponse)
+			return
+		}
+		th.TestFormValues(t, r, project)
+		fmt.Fprint(w, ListNetworksResponse)
+	})
+}
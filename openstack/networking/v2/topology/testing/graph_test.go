@@ -0,0 +1,149 @@
+package testing
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/external"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/subnets"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/topology"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func newGraph() *topology.Graph {
+	return topology.New(topology.Resources{
+		Networks: []topology.NetworkWithExternal{
+			{Network: networks.Network{ID: privateNetID, Name: "private"}},
+			{Network: networks.Network{ID: publicNetID, Name: "public"}, NetworkExternalExt: external.NetworkExternalExt{External: true}},
+		},
+		Subnets: []subnets.Subnet{
+			{ID: subnetID, Name: "subnet1", NetworkID: privateNetID, CIDR: "10.0.0.0/24"},
+		},
+		Ports: []ports.Port{
+			{
+				ID:          serverPort,
+				NetworkID:   privateNetID,
+				DeviceOwner: "compute:nova",
+				DeviceID:    serverID,
+				FixedIPs:    []ports.IP{{SubnetID: subnetID, IPAddress: "10.0.0.5"}},
+			},
+			{
+				ID:          lbPort,
+				NetworkID:   privateNetID,
+				DeviceOwner: "Octavia",
+				FixedIPs:    []ports.IP{{SubnetID: subnetID, IPAddress: "10.0.0.9"}},
+			},
+		},
+		FloatingIPs: []floatingips.FloatingIP{
+			{ID: floatingIPID, FloatingNetworkID: publicNetID, PortID: serverPort, FixedIP: "10.0.0.5"},
+		},
+		Servers: []servers.Server{
+			{ID: serverID, Name: "app", Status: "ACTIVE"},
+		},
+		LoadBalancers: []loadbalancers.LoadBalancer{
+			{ID: lbID, Name: "lb1", VipPortID: lbPort, VipAddress: "10.0.0.9"},
+		},
+	})
+}
+
+func TestPath(t *testing.T) {
+	g := newGraph()
+
+	p, err := g.Path(topology.Ref{Type: topology.Server, ID: serverID}, topology.Ref{Type: topology.Network, ID: publicNetID})
+	th.AssertNoErr(t, err)
+
+	var refs []string
+	for _, n := range p.Nodes {
+		refs = append(refs, n.Ref().String())
+	}
+	th.AssertDeepEquals(t, []string{
+		"server:" + serverID,
+		"port:" + serverPort,
+		"floating_ip:" + floatingIPID,
+		"network:" + publicNetID,
+	}, refs)
+	th.AssertEquals(t, 3, len(p.Edges))
+	th.AssertEquals(t, topology.EdgeServerPort, p.Edges[0].Type)
+	th.AssertEquals(t, topology.EdgeFloatingIPPort, p.Edges[1].Type)
+	th.AssertEquals(t, topology.EdgeFloatingIPNetwork, p.Edges[2].Type)
+
+	_, err = g.Path(topology.Ref{Type: topology.Server, ID: "unknown"}, topology.Ref{Type: topology.Network, ID: publicNetID})
+	_, ok := err.(topology.ErrNodeNotFound)
+	th.AssertEquals(t, true, ok)
+}
+
+func TestPathNotConnected(t *testing.T) {
+	g := newGraph()
+	g.AddNode(topology.Node{Type: topology.Router, ID: routerID, Name: "router1"})
+
+	_, err := g.Path(topology.Ref{Type: topology.Server, ID: serverID}, topology.Ref{Type: topology.Router, ID: routerID})
+	_, ok := err.(topology.ErrNoPath)
+	th.AssertEquals(t, true, ok)
+}
+
+func TestNeighbors(t *testing.T) {
+	g := newGraph()
+
+	var names []string
+	for _, n := range g.Neighbors(topology.Ref{Type: topology.Subnet, ID: subnetID}) {
+		names = append(names, n.Ref().String())
+	}
+	th.AssertDeepEquals(t, []string{
+		"network:" + privateNetID,
+		"port:" + lbPort,
+		"port:" + serverPort,
+	}, names)
+
+	lb := g.Find(topology.LoadBalancer, "lb1")
+	th.AssertEquals(t, 1, len(lb))
+	edges := g.EdgesOf(lb[0].Ref())
+	th.AssertEquals(t, 1, len(edges))
+	th.AssertEquals(t, topology.EdgeLoadBalancerVIP, edges[0].Type)
+}
+
+func TestAddNodeReplacesPlaceholder(t *testing.T) {
+	g := topology.New(topology.Resources{
+		Ports: []ports.Port{{ID: serverPort, NetworkID: privateNetID, DeviceOwner: "compute:nova", DeviceID: serverID}},
+	})
+
+	n, _ := g.Node(topology.Ref{Type: topology.Server, ID: serverID})
+	th.AssertEquals(t, true, n.Placeholder)
+
+	g.AddNode(topology.Node{Type: topology.Server, ID: serverID, Name: "app"})
+	n, _ = g.Node(topology.Ref{Type: topology.Server, ID: serverID})
+	th.AssertEquals(t, false, n.Placeholder)
+	th.AssertEquals(t, "app", n.Name)
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	g := newGraph()
+
+	var buf bytes.Buffer
+	th.AssertNoErr(t, g.WriteJSON(&buf))
+
+	var actual topology.Graph
+	th.AssertNoErr(t, json.Unmarshal(buf.Bytes(), &actual))
+
+	th.CheckDeepEquals(t, g.Nodes(), actual.Nodes())
+	th.CheckDeepEquals(t, g.Edges(), actual.Edges())
+}
+
+func TestWriteDOT(t *testing.T) {
+	g := newGraph()
+
+	var buf bytes.Buffer
+	th.AssertNoErr(t, g.WriteDOT(&buf))
+	out := buf.String()
+
+	th.AssertEquals(t, true, strings.HasPrefix(out, "graph topology {\n"))
+	th.AssertEquals(t, true, strings.HasSuffix(out, "}\n"))
+	th.AssertEquals(t, true, strings.Contains(out, `"subnet:`+subnetID+`" [label="subnet1\nsubnet\n10.0.0.0/24"`))
+	th.AssertEquals(t, true, strings.Contains(out, `"floating_ip:`+floatingIPID+`" -- "port:`+serverPort+`" [label="floating_ip_port\n10.0.0.5"];`))
+}
@@ -0,0 +1,66 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/topology"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestCollect(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNetworkResources(t)
+
+	res, err := topology.Collect(context.TODO(), topology.Clients{Network: fake.ServiceClient()}, topology.Opts{ProjectID: projectID})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(res.VPCs))
+	th.AssertEquals(t, 2, len(res.Networks))
+	th.AssertEquals(t, "private", res.Networks[0].Name)
+	th.AssertEquals(t, false, res.Networks[0].External)
+	th.AssertEquals(t, "public", res.Networks[1].Name)
+	th.AssertEquals(t, true, res.Networks[1].External)
+	th.AssertEquals(t, 1, len(res.Subnets))
+	th.AssertEquals(t, 1, len(res.Routers))
+	th.AssertEquals(t, 4, len(res.Ports))
+	th.AssertEquals(t, 1, len(res.FloatingIPs))
+	th.AssertEquals(t, 1, len(res.Trunks))
+
+	// The peering connection between two VPCs of other projects is dropped.
+	th.AssertEquals(t, 1, len(res.PeeringConnections))
+	th.AssertEquals(t, "3c7f1a52-5e3b-4c6a-9b1f-0d3e6f1a2b44", res.PeeringConnections[0].ID)
+}
+
+func TestCollectRequiresNetworkClient(t *testing.T) {
+	_, err := topology.Collect(context.TODO(), topology.Clients{}, topology.Opts{})
+	th.AssertErr(t, err)
+}
+
+func TestBuild(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNetworkResources(t)
+
+	g, err := topology.Build(context.TODO(), topology.Clients{Network: fake.ServiceClient()}, topology.Opts{ProjectID: projectID})
+	th.AssertNoErr(t, err)
+
+	// The server is not collected without a compute client, but its ports
+	// refer to it.
+	server, ok := g.Node(topology.Ref{Type: topology.Server, ID: serverID})
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, true, server.Placeholder)
+
+	// The DHCP port is left out.
+	th.AssertEquals(t, 3, len(g.NodesOfType(topology.Port)))
+
+	public := g.Find(topology.Network, "public")
+	th.AssertEquals(t, 1, len(public))
+	th.AssertDeepEquals(t, map[string]string{"external": "true"}, public[0].Attributes)
+
+	peer, ok := g.Node(topology.Ref{Type: topology.VPC, ID: peerVPCID})
+	th.AssertEquals(t, true, ok)
+	th.AssertEquals(t, true, peer.Placeholder)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
 	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
 )
 
@@ -133,3 +134,14 @@ func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r Del
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// IDFromName is a convenience function that returns a VPC's ID given its name.
+// The lookup is filtered by name server-side and matched exactly client-side.
+//
+// It returns a gophercloud.ErrResourceNotFound if no VPC has that name and a
+// gophercloud.ErrMultipleResourcesFound if more than one does.
+func IDFromName(ctx context.Context, client *gophercloud.ServiceClient, name string) (string, error) {
+	return utils.ResolveID(ctx, List(client, ListOpts{Name: name}), ExtractVPCs, func(v VPC) (string, string) {
+		return v.Name, v.ID
+	}, "VPC", name)
+}
@@ -0,0 +1,2 @@
+// vpcs unit tests
+package testing
@@ -0,0 +1,26 @@
+package testing
+
+const ListResponse = `
+{
+    "vpcs": [
+        {
+            "id": "2b6ee2c8-6a0a-4c65-a0d3-6e2a3f1b7d41",
+            "name": "prod",
+            "cidr": "10.0.0.0/16",
+            "status": "ACTIVE"
+        },
+        {
+            "id": "7f3c1b5e-9d2a-4e8f-b6c4-1a0d5e9f2c83",
+            "name": "dev",
+            "cidr": "10.1.0.0/16",
+            "status": "ACTIVE"
+        },
+        {
+            "id": "c4a9e2d7-3b1f-4c6e-8a5d-0f7b2e9c1d64",
+            "name": "dev",
+            "cidr": "10.2.0.0/16",
+            "status": "ACTIVE"
+        }
+    ]
+}
+`
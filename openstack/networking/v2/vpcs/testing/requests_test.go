@@ -0,0 +1,58 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/vpcs"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func handleIDFromName(t *testing.T, name string) {
+	th.Mux.HandleFunc("/v2.0/vpcs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"name": name})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListResponse)
+	})
+}
+
+func TestIDFromName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleIDFromName(t, "prod")
+
+	id, err := vpcs.IDFromName(context.TODO(), fake.ServiceClient(), "prod")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2b6ee2c8-6a0a-4c65-a0d3-6e2a3f1b7d41", id)
+}
+
+func TestIDFromNameNotFound(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleIDFromName(t, "staging")
+
+	_, err := vpcs.IDFromName(context.TODO(), fake.ServiceClient(), "staging")
+	if _, ok := err.(gophercloud.ErrResourceNotFound); !ok {
+		t.Fatalf("Expected ErrResourceNotFound, got %v", err)
+	}
+}
+
+func TestIDFromNameMultipleFound(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleIDFromName(t, "dev")
+
+	_, err := vpcs.IDFromName(context.TODO(), fake.ServiceClient(), "dev")
+	if _, ok := err.(gophercloud.ErrMultipleResourcesFound); !ok {
+		t.Fatalf("Expected ErrMultipleResourcesFound, got %v", err)
+	}
+}
@@ -0,0 +1,323 @@
+package billing
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/flavors"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/usage"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// Clients holds the service clients queried by Generate.
+type Clients struct {
+	Compute      *gophercloud.ServiceClient
+	BlockStorage *gophercloud.ServiceClient
+	Network      *gophercloud.ServiceClient
+}
+
+// Opts configures Generate.
+type Opts struct {
+	// Start and End bound the window the report covers.
+	Start time.Time
+	End   time.Time
+
+	// RateCard prices the line items.
+	RateCard RateCard
+
+	// ProjectID restricts the report to a single project. Every project is
+	// reported if not set.
+	ProjectID string
+
+	// PageSize is the number of server usages fetched per request. It
+	// requires microversion 2.40 or later.
+	PageSize int
+}
+
+// Generate reports the cost of the servers, volumes and floating IPs of
+// every project over a window.
+//
+// Servers are reported from the usage of the compute service, which is
+// fetched a page at a time, and priced along with the extra specs of their
+// flavor. Volumes and floating IPs are reported from the ones which exist,
+// from their creation or the start of the window until its end; resources
+// deleted since the start of the window are not reported. Services whose
+// client is not set in Clients are not queried.
+func Generate(ctx context.Context, clients Clients, opts Opts) (*Report, error) {
+	if clients.Compute == nil {
+		return nil, fmt.Errorf("a compute client is required")
+	}
+	if opts.RateCard == nil {
+		return nil, fmt.Errorf("a rate card is required")
+	}
+	if !opts.End.After(opts.Start) {
+		return nil, fmt.Errorf("the end of the window must be after its start")
+	}
+
+	g := &generator{
+		clients: clients,
+		opts:    opts,
+		report:  &Report{Start: opts.Start, End: opts.End},
+	}
+	if err := g.loadFlavors(ctx); err != nil {
+		return nil, err
+	}
+	if err := g.servers(ctx); err != nil {
+		return nil, err
+	}
+	if clients.BlockStorage != nil {
+		if err := g.volumes(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if clients.Network != nil {
+		if err := g.floatingIPs(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	g.summarize()
+	return g.report, nil
+}
+
+type generator struct {
+	clients Clients
+	opts    Opts
+	report  *Report
+
+	// flavors maps flavor names, as reported by usages, to flavors.
+	flavors map[string]*flavors.Flavor
+}
+
+// add prices a line item and adds it to the report.
+func (g *generator) add(l Line) error {
+	cost, err := g.opts.RateCard.Cost(l)
+	if err != nil {
+		return fmt.Errorf("unable to price %s %s: %w", l.Kind, l.ResourceID, err)
+	}
+	l.Cost = cost
+	g.report.Lines = append(g.report.Lines, l)
+	return nil
+}
+
+func (g *generator) loadFlavors(ctx context.Context) error {
+	g.flavors = make(map[string]*flavors.Flavor)
+	pages, err := flavors.ListDetail(g.clients.Compute, flavors.ListOpts{AccessType: flavors.AllAccess}).AllPages(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list flavors: %w", err)
+	}
+	all, err := flavors.ExtractFlavors(pages)
+	if err != nil {
+		return err
+	}
+	for i := range all {
+		g.flavors[all[i].Name] = &all[i]
+	}
+	return nil
+}
+
+// extraSpecs returns the extra specs of a flavor, which are only listed
+// along with flavors as of microversion 2.61.
+func (g *generator) extraSpecs(ctx context.Context, name string) (map[string]string, error) {
+	f, ok := g.flavors[name]
+	if !ok {
+		return nil, nil
+	}
+	if f.ExtraSpecs == nil {
+		specs, err := flavors.ListExtraSpecs(ctx, g.clients.Compute, f.ID).Extract()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the extra specs of flavor %s: %w", name, err)
+		}
+		if specs == nil {
+			specs = map[string]string{}
+		}
+		f.ExtraSpecs = specs
+	}
+	return f.ExtraSpecs, nil
+}
+
+func (g *generator) servers(ctx context.Context) error {
+	var pager pagination.Pager
+	if g.opts.ProjectID != "" {
+		pager = usage.SingleTenant(g.clients.Compute, g.opts.ProjectID, usage.SingleTenantOpts{
+			Start: &g.opts.Start,
+			End:   &g.opts.End,
+			Limit: g.opts.PageSize,
+		})
+	} else {
+		pager = usage.AllTenants(g.clients.Compute, usage.AllTenantsOpts{
+			Detailed: true,
+			Start:    &g.opts.Start,
+			End:      &g.opts.End,
+			Limit:    g.opts.PageSize,
+		})
+	}
+
+	// A project may span several pages; its server usages are added page
+	// by page.
+	err := pager.EachPage(ctx, func(ctx context.Context, page pagination.Page) (bool, error) {
+		var tenants []usage.TenantUsage
+		if g.opts.ProjectID != "" {
+			tenant, err := usage.ExtractSingleTenant(page)
+			if err != nil {
+				return false, err
+			}
+			tenants = append(tenants, *tenant)
+		} else {
+			var err error
+			if tenants, err = usage.ExtractAllTenants(page); err != nil {
+				return false, err
+			}
+		}
+
+		for _, tenant := range tenants {
+			for _, s := range tenant.ServerUsages {
+				specs, err := g.extraSpecs(ctx, s.Flavor)
+				if err != nil {
+					return false, err
+				}
+				projectID := s.TenantID
+				if projectID == "" {
+					projectID = tenant.TenantID
+				}
+				l := Line{
+					ProjectID:  projectID,
+					Kind:       KindServer,
+					ResourceID: s.InstanceID,
+					Name:       s.Name,
+					Flavor:     s.Flavor,
+					VCPUs:      s.VCPUs,
+					MemoryMB:   s.MemoryMB,
+					LocalGB:    s.LocalGB,
+					ExtraSpecs: specs,
+					Hours:      s.Hours,
+				}
+				if err := g.add(l); err != nil {
+					return false, err
+				}
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get server usages: %w", err)
+	}
+	return nil
+}
+
+func (g *generator) volumes(ctx context.Context) error {
+	listOpts := volumes.ListOpts{
+		AllTenants: true,
+		TenantID:   g.opts.ProjectID,
+	}
+	err := volumes.List(g.clients.BlockStorage, listOpts).EachPage(ctx, func(_ context.Context, page pagination.Page) (bool, error) {
+		all, err := volumes.ExtractVolumes(page)
+		if err != nil {
+			return false, err
+		}
+		for _, v := range all {
+			hours := g.hours(v.CreatedAt)
+			if hours == 0 {
+				continue
+			}
+			l := Line{
+				ProjectID:  v.TenantID,
+				Kind:       KindVolume,
+				ResourceID: v.ID,
+				Name:       v.Name,
+				VolumeType: v.VolumeType,
+				SizeGB:     v.Size,
+				Hours:      hours,
+			}
+			if err := g.add(l); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list volumes: %w", err)
+	}
+	return nil
+}
+
+func (g *generator) floatingIPs(ctx context.Context) error {
+	listOpts := floatingips.ListOpts{
+		ProjectID: g.opts.ProjectID,
+	}
+	err := floatingips.List(g.clients.Network, listOpts).EachPage(ctx, func(_ context.Context, page pagination.Page) (bool, error) {
+		all, err := floatingips.ExtractFloatingIPs(page)
+		if err != nil {
+			return false, err
+		}
+		for _, fip := range all {
+			hours := g.hours(fip.CreatedAt)
+			if hours == 0 {
+				continue
+			}
+			l := Line{
+				ProjectID:  fip.ProjectID,
+				Kind:       KindFloatingIP,
+				ResourceID: fip.ID,
+				Name:       fip.FloatingIP,
+				Hours:      hours,
+			}
+			if err := g.add(l); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list floating IPs: %w", err)
+	}
+	return nil
+}
+
+// hours returns the time a resource created at createdAt existed within the
+// window.
+func (g *generator) hours(createdAt time.Time) float64 {
+	from := g.opts.Start
+	if createdAt.After(from) {
+		from = createdAt
+	}
+	if !g.opts.End.After(from) {
+		return 0
+	}
+	return g.opts.End.Sub(from).Hours()
+}
+
+// summarize sorts the line items and adds up the cost of each project.
+func (g *generator) summarize() {
+	slices.SortFunc(g.report.Lines, func(a, b Line) int {
+		return cmp.Or(
+			cmp.Compare(a.ProjectID, b.ProjectID),
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.ResourceID, b.ResourceID),
+		)
+	})
+
+	for _, l := range g.report.Lines {
+		n := len(g.report.Projects)
+		if n == 0 || g.report.Projects[n-1].ProjectID != l.ProjectID {
+			g.report.Projects = append(g.report.Projects, ProjectTotal{ProjectID: l.ProjectID})
+			n++
+		}
+		p := &g.report.Projects[n-1]
+		switch l.Kind {
+		case KindServer:
+			p.Servers += l.Cost
+		case KindVolume:
+			p.Volumes += l.Cost
+		case KindFloatingIP:
+			p.FloatingIPs += l.Cost
+		}
+		p.Total += l.Cost
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Package billing reports the cost of the resources of projects over a window of
+time, from the usage of the compute service, the size of block storage volumes
+and the floating IPs of the networking service.
+
+Generate streams the server usages a page at a time, and passes each server
+along with the extra specs of its flavor to a RateCard, which prices it.
+Volumes and floating IPs are priced the same way. StaticRateCard reads the
+hourly price of servers from a flavor extra spec, and falls back to pricing
+them by size.
+
+Example to Generate a Monthly Report
+
+	report, err := billing.Generate(context.TODO(), billing.Clients{
+		Compute:      computeClient,
+		BlockStorage: blockStorageClient,
+		Network:      networkClient,
+	}, billing.Opts{
+		Start: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		RateCard: billing.StaticRateCard{
+			VCPUHour:         0.02,
+			MemoryGBHour:     0.005,
+			VolumeGBHour:     0.0001,
+			VolumeTypeGBHour: map[string]float64{"ssd": 0.0002},
+			FloatingIPHour:   0.004,
+		},
+		PageSize: 1000,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if err := report.WriteCSV(os.Stdout); err != nil {
+		panic(err)
+	}
+
+	if err := report.WriteSummaryCSV(os.Stdout); err != nil {
+		panic(err)
+	}
+
+Example to Use a Custom Rate Card
+
+	rateCard := billing.RateCardFunc(func(l billing.Line) (float64, error) {
+		if l.Kind == billing.KindServer && l.ExtraSpecs["trait:CUSTOM_GPU"] == "required" {
+			return l.Hours * 1.5, nil
+		}
+		return defaultRateCard.Cost(l)
+	})
+
+	report, err := billing.Generate(context.TODO(), clients, billing.Opts{
+		Start:     start,
+		End:       end,
+		RateCard:  rateCard,
+		ProjectID: "aabbccddeeff112233445566",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if err := report.WriteJSON(os.Stdout); err != nil {
+		panic(err)
+	}
+*/
+package billing
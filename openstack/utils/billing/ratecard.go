@@ -0,0 +1,83 @@
+package billing
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// DefaultFlavorPriceKey is the flavor extra spec StaticRateCard reads the
+// hourly price of a server from when FlavorPriceKey is not set.
+const DefaultFlavorPriceKey = "billing:hourly_price"
+
+// RateCard prices the line items of a report.
+type RateCard interface {
+	// Cost returns the cost of a line item over its hours.
+	Cost(Line) (float64, error)
+}
+
+// RateCardFunc is a function which implements RateCard.
+type RateCardFunc func(Line) (float64, error)
+
+// Cost calls f(l).
+func (f RateCardFunc) Cost(l Line) (float64, error) {
+	return f(l)
+}
+
+// StaticRateCard is a RateCard with fixed hourly prices.
+//
+// A server is priced with the hourly price held by the FlavorPriceKey extra
+// spec of its flavor. If its flavor has no such extra spec, e.g. because it
+// was deleted, the server is priced by its size instead.
+type StaticRateCard struct {
+	// FlavorPriceKey is the flavor extra spec holding the hourly price of
+	// a server. Defaults to DefaultFlavorPriceKey.
+	FlavorPriceKey string
+
+	// ServerHour, VCPUHour, MemoryGBHour and LocalGBHour add up to the
+	// hourly price of servers whose flavor has no price.
+	ServerHour   float64
+	VCPUHour     float64
+	MemoryGBHour float64
+	LocalGBHour  float64
+
+	// VolumeGBHour is the hourly price of a GiB of volume.
+	VolumeGBHour float64
+
+	// VolumeTypeGBHour overrides VolumeGBHour for some volume types.
+	VolumeTypeGBHour map[string]float64
+
+	// FloatingIPHour is the hourly price of a floating IP.
+	FloatingIPHour float64
+}
+
+// Cost implements RateCard.
+func (c StaticRateCard) Cost(l Line) (float64, error) {
+	switch l.Kind {
+	case KindServer:
+		key := c.FlavorPriceKey
+		if key == "" {
+			key = DefaultFlavorPriceKey
+		}
+		if v, ok := l.ExtraSpecs[key]; ok {
+			price, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid price %q of flavor %s: %w", v, l.Flavor, err)
+			}
+			return l.Hours * price, nil
+		}
+		price := c.ServerHour +
+			float64(l.VCPUs)*c.VCPUHour +
+			float64(l.MemoryMB)/1024*c.MemoryGBHour +
+			float64(l.LocalGB)*c.LocalGBHour
+		return l.Hours * price, nil
+	case KindVolume:
+		price := c.VolumeGBHour
+		if p, ok := c.VolumeTypeGBHour[l.VolumeType]; ok {
+			price = p
+		}
+		return l.Hours * float64(l.SizeGB) * price, nil
+	case KindFloatingIP:
+		return l.Hours * c.FloatingIPHour, nil
+	}
+	return 0, fmt.Errorf("unknown line kind %q", l.Kind)
+}
@@ -0,0 +1,117 @@
+package billing
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Kind is the kind of resource a line item is about.
+type Kind string
+
+const (
+	KindServer     Kind = "server"
+	KindVolume     Kind = "volume"
+	KindFloatingIP Kind = "floating_ip"
+)
+
+// Line is the usage and cost of a single resource over the window of a
+// report.
+type Line struct {
+	ProjectID  string `json:"project_id"`
+	Kind       Kind   `json:"kind"`
+	ResourceID string `json:"resource_id"`
+
+	// Name is the name of a server or volume, or the address of a floating
+	// IP.
+	Name string `json:"name,omitempty"`
+
+	// Flavor, VCPUs, MemoryMB and LocalGB describe a server.
+	Flavor   string `json:"flavor,omitempty"`
+	VCPUs    int    `json:"vcpus,omitempty"`
+	MemoryMB int    `json:"memory_mb,omitempty"`
+	LocalGB  int    `json:"local_gb,omitempty"`
+
+	// ExtraSpecs are the extra specs of the flavor of a server, if the
+	// flavor still exists.
+	ExtraSpecs map[string]string `json:"-"`
+
+	// VolumeType and SizeGB describe a volume.
+	VolumeType string `json:"volume_type,omitempty"`
+	SizeGB     int    `json:"size_gb,omitempty"`
+
+	// Hours is the time the resource existed within the window.
+	Hours float64 `json:"hours"`
+
+	Cost float64 `json:"cost"`
+}
+
+// ProjectTotal is the cost of a project, broken down by kind of resource.
+type ProjectTotal struct {
+	ProjectID   string  `json:"project_id"`
+	Servers     float64 `json:"servers"`
+	Volumes     float64 `json:"volumes"`
+	FloatingIPs float64 `json:"floating_ips"`
+	Total       float64 `json:"total"`
+}
+
+// Report is the cost of the resources of every project over a window.
+type Report struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+
+	// Lines are sorted by project, kind and resource ID.
+	Lines []Line `json:"lines"`
+
+	// Projects are sorted by project ID.
+	Projects []ProjectTotal `json:"projects"`
+}
+
+// Total returns the cost of every project.
+func (r Report) Total() float64 {
+	var total float64
+	for _, p := range r.Projects {
+		total += p.Total
+	}
+	return total
+}
+
+// WriteJSON writes the report as an indented JSON document.
+func (r Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteCSV writes a CSV document with a header and a record per line item.
+func (r Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"project_id", "kind", "resource_id", "name", "flavor", "volume_type", "size_gb", "hours", "cost"})
+	for _, l := range r.Lines {
+		size := ""
+		if l.Kind == KindVolume {
+			size = strconv.Itoa(l.SizeGB)
+		}
+		cw.Write([]string{l.ProjectID, string(l.Kind), l.ResourceID, l.Name, l.Flavor, l.VolumeType, size, formatFloat(l.Hours), formatFloat(l.Cost)})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteSummaryCSV writes a CSV document with a header and a record per
+// project.
+func (r Report) WriteSummaryCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"project_id", "servers", "volumes", "floating_ips", "total"})
+	for _, p := range r.Projects {
+		cw.Write([]string{p.ProjectID, formatFloat(p.Servers), formatFloat(p.Volumes), formatFloat(p.FloatingIPs), formatFloat(p.Total)})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 4, 64)
+}
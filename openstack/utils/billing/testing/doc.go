@@ -0,0 +1,2 @@
+// Package testing includes billing unit tests
+package testing
@@ -0,0 +1,257 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const (
+	FirstProjectID  = "aabbccddeeff112233445566"
+	SecondProjectID = "665544332211ffeeddccbbaa"
+)
+
+// ListFlavorsResponse lists a flavor with a price, and one whose extra specs
+// are not listed along with it.
+const ListFlavorsResponse = `
+{
+    "flavors": [
+        {
+            "id": "1",
+            "name": "m1.small",
+            "vcpus": 1,
+            "ram": 2048,
+            "disk": 20,
+            "extra_specs": {"billing:hourly_price": "0.05"}
+        },
+        {
+            "id": "2",
+            "name": "m1.large",
+            "vcpus": 4,
+            "ram": 8192,
+            "disk": 80
+        }
+    ]
+}
+`
+
+const ListExtraSpecsResponse = `
+{
+    "extra_specs": {"hw:cpu_policy": "dedicated"}
+}
+`
+
+// AllTenantsFirstPage holds the first page of usages, which links to the
+// second one.
+const AllTenantsFirstPage = `
+{
+    "tenant_usages": [
+        {
+            "server_usages": [
+                {
+                    "ended_at": null,
+                    "flavor": "m1.small",
+                    "hours": 10,
+                    "instance_id": "a70096fd-8196-406b-86c4-045840f53ad7",
+                    "local_gb": 20,
+                    "memory_mb": 2048,
+                    "name": "web",
+                    "started_at": "2026-09-01T14:00:00.000000",
+                    "state": "active",
+                    "tenant_id": "aabbccddeeff112233445566",
+                    "uptime": 36000,
+                    "vcpus": 1
+                }
+            ],
+            "start": "2026-09-01T00:00:00.000000",
+            "stop": "2026-09-02T00:00:00.000000",
+            "tenant_id": "aabbccddeeff112233445566",
+            "total_hours": 10,
+            "total_local_gb_usage": 200,
+            "total_memory_mb_usage": 20480,
+            "total_vcpus_usage": 10
+        }
+    ],
+    "tenant_usages_links": [
+        {
+            "href": "%s/os-simple-tenant-usage?detailed=1&end=2026-09-02T00%%3A00%%3A00&limit=1&start=2026-09-01T00%%3A00%%3A00&marker=a70096fd-8196-406b-86c4-045840f53ad7",
+            "rel": "next"
+        }
+    ]
+}
+`
+
+// AllTenantsSecondPage continues the usage of the first project, whose
+// server has a deleted flavor, and holds the usage of the second project.
+const AllTenantsSecondPage = `
+{
+    "tenant_usages": [
+        {
+            "server_usages": [
+                {
+                    "ended_at": "2026-09-01T05:00:00.000000",
+                    "flavor": "m1.deleted",
+                    "hours": 5,
+                    "instance_id": "c04e38f2-dcee-4ca8-9466-7708d0a9b6dd",
+                    "local_gb": 20,
+                    "memory_mb": 4096,
+                    "name": "batch",
+                    "started_at": "2026-08-30T00:00:00.000000",
+                    "state": "terminated",
+                    "tenant_id": "aabbccddeeff112233445566",
+                    "uptime": 0,
+                    "vcpus": 2
+                }
+            ],
+            "start": "2026-09-01T00:00:00.000000",
+            "stop": "2026-09-02T00:00:00.000000",
+            "tenant_id": "aabbccddeeff112233445566",
+            "total_hours": 5,
+            "total_local_gb_usage": 100,
+            "total_memory_mb_usage": 20480,
+            "total_vcpus_usage": 10
+        },
+        {
+            "server_usages": [
+                {
+                    "ended_at": null,
+                    "flavor": "m1.large",
+                    "hours": 24,
+                    "instance_id": "ceb654fa-e0e8-44fb-8942-e4d0bfad3941",
+                    "local_gb": 80,
+                    "memory_mb": 8192,
+                    "name": "db",
+                    "started_at": "2026-08-01T00:00:00.000000",
+                    "state": "active",
+                    "tenant_id": "665544332211ffeeddccbbaa",
+                    "uptime": 0,
+                    "vcpus": 4
+                }
+            ],
+            "start": "2026-09-01T00:00:00.000000",
+            "stop": "2026-09-02T00:00:00.000000",
+            "tenant_id": "665544332211ffeeddccbbaa",
+            "total_hours": 24,
+            "total_local_gb_usage": 1920,
+            "total_memory_mb_usage": 196608,
+            "total_vcpus_usage": 96
+        }
+    ]
+}
+`
+
+// ListVolumesResponse lists a volume created before the window, one
+// created halfway through it and one created after it.
+const ListVolumesResponse = `
+{
+    "volumes": [
+        {
+            "id": "289da7f8-6440-407c-9fb4-7db01ec49164",
+            "name": "web-data",
+            "size": 100,
+            "volume_type": "ssd",
+            "created_at": "2026-08-01T00:00:00.000000",
+            "os-vol-tenant-attr:tenant_id": "aabbccddeeff112233445566"
+        },
+        {
+            "id": "96c3bda7-c82a-4f50-be73-ca7621794835",
+            "name": "db-backup",
+            "size": 10,
+            "volume_type": "standard",
+            "created_at": "2026-09-01T12:00:00.000000",
+            "os-vol-tenant-attr:tenant_id": "665544332211ffeeddccbbaa"
+        },
+        {
+            "id": "c5a9e4b8-1f3d-4f6b-8c2e-0a9d8b7c6e5f",
+            "name": "later",
+            "size": 10,
+            "volume_type": "standard",
+            "created_at": "2026-09-03T00:00:00.000000",
+            "os-vol-tenant-attr:tenant_id": "665544332211ffeeddccbbaa"
+        }
+    ]
+}
+`
+
+const ListFloatingIPsResponse = `
+{
+    "floatingips": [
+        {
+            "id": "2f245a7b-796b-4f26-9cf9-9e82d248fda7",
+            "floating_ip_address": "172.24.4.228",
+            "project_id": "aabbccddeeff112233445566",
+            "tenant_id": "aabbccddeeff112233445566",
+            "created_at": "2026-08-15T00:00:00Z"
+        }
+    ]
+}
+`
+
+// ExpectedCSV is the report of the fixtures priced with rateCard.
+const ExpectedCSV = `project_id,kind,resource_id,name,flavor,volume_type,size_gb,hours,cost
+665544332211ffeeddccbbaa,server,ceb654fa-e0e8-44fb-8942-e4d0bfad3941,db,m1.large,,,24.0000,3.3120
+665544332211ffeeddccbbaa,volume,96c3bda7-c82a-4f50-be73-ca7621794835,db-backup,,standard,10,12.0000,0.0120
+aabbccddeeff112233445566,floating_ip,2f245a7b-796b-4f26-9cf9-9e82d248fda7,172.24.4.228,,,,24.0000,0.0960
+aabbccddeeff112233445566,server,a70096fd-8196-406b-86c4-045840f53ad7,web,m1.small,,,10.0000,0.5000
+aabbccddeeff112233445566,server,c04e38f2-dcee-4ca8-9466-7708d0a9b6dd,batch,m1.deleted,,,5.0000,0.3600
+aabbccddeeff112233445566,volume,289da7f8-6440-407c-9fb4-7db01ec49164,web-data,,ssd,100,24.0000,0.4800
+`
+
+const ExpectedSummaryCSV = `project_id,servers,volumes,floating_ips,total
+665544332211ffeeddccbbaa,3.3120,0.0120,0.0000,3.3240
+aabbccddeeff112233445566,0.8600,0.4800,0.0960,1.4360
+`
+
+func serviceClient(prefix string) *gophercloud.ServiceClient {
+	sc := fake.ServiceClient()
+	sc.ResourceBase = sc.Endpoint + prefix + "/"
+	return sc
+}
+
+func handle(t *testing.T, path, body string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, body)
+	})
+}
+
+// HandleResourcesSuccessfully registers the flavors, usages, volumes and
+// floating IPs of two projects.
+func HandleResourcesSuccessfully(t *testing.T) {
+	handle(t, "/compute/flavors/detail", ListFlavorsResponse)
+	handle(t, "/compute/flavors/2/os-extra_specs", ListExtraSpecsResponse)
+	handle(t, "/volume/volumes/detail", ListVolumesResponse)
+	handle(t, "/network/floatingips", ListFloatingIPsResponse)
+
+	th.Mux.HandleFunc("/compute/os-simple-tenant-usage", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		values := map[string]string{
+			"detailed": "1",
+			"limit":    "1",
+			"start":    "2026-09-01T00:00:00",
+			"end":      "2026-09-02T00:00:00",
+		}
+		marker := r.URL.Query().Get("marker")
+		if marker != "" {
+			values["marker"] = marker
+		}
+		th.TestFormValues(t, r, values)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if marker == "" {
+			fmt.Fprintf(w, AllTenantsFirstPage, th.Server.URL+"/compute")
+		} else {
+			fmt.Fprint(w, AllTenantsSecondPage)
+		}
+	})
+}
@@ -0,0 +1,124 @@
+package testing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils/billing"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func clients() billing.Clients {
+	return billing.Clients{
+		Compute:      serviceClient("compute"),
+		BlockStorage: serviceClient("volume"),
+		Network:      serviceClient("network"),
+	}
+}
+
+var rateCard = billing.StaticRateCard{
+	ServerHour:       0.01,
+	VCPUHour:         0.02,
+	MemoryGBHour:     0.005,
+	LocalGBHour:      0.0001,
+	VolumeGBHour:     0.0001,
+	VolumeTypeGBHour: map[string]float64{"ssd": 0.0002},
+	FloatingIPHour:   0.004,
+}
+
+func opts() billing.Opts {
+	return billing.Opts{
+		Start:    time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		End:      time.Date(2026, 9, 2, 0, 0, 0, 0, time.UTC),
+		RateCard: rateCard,
+		PageSize: 1,
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleResourcesSuccessfully(t)
+
+	report, err := billing.Generate(context.TODO(), clients(), opts())
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 6, len(report.Lines))
+	th.AssertEquals(t, 2, len(report.Projects))
+	th.AssertEquals(t, "4.7600", fmt.Sprintf("%.4f", report.Total()))
+
+	// The extra specs of m1.large were fetched separately.
+	th.AssertDeepEquals(t, map[string]string{"hw:cpu_policy": "dedicated"}, report.Lines[0].ExtraSpecs)
+
+	var csv bytes.Buffer
+	th.AssertNoErr(t, report.WriteCSV(&csv))
+	th.AssertEquals(t, ExpectedCSV, csv.String())
+
+	var summary bytes.Buffer
+	th.AssertNoErr(t, report.WriteSummaryCSV(&summary))
+	th.AssertEquals(t, ExpectedSummaryCSV, summary.String())
+}
+
+func TestGenerateJSON(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleResourcesSuccessfully(t)
+
+	report, err := billing.Generate(context.TODO(), clients(), opts())
+	th.AssertNoErr(t, err)
+
+	var b bytes.Buffer
+	th.AssertNoErr(t, report.WriteJSON(&b))
+
+	var actual billing.Report
+	th.AssertNoErr(t, json.Unmarshal(b.Bytes(), &actual))
+	th.AssertEquals(t, report.Start, actual.Start)
+	th.AssertEquals(t, 6, len(actual.Lines))
+	th.AssertEquals(t, billing.KindVolume, actual.Lines[1].Kind)
+	th.AssertEquals(t, "standard", actual.Lines[1].VolumeType)
+	th.AssertEquals(t, 10, actual.Lines[1].SizeGB)
+	th.AssertDeepEquals(t, report.Projects, actual.Projects)
+}
+
+func TestGenerateComputeOnly(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleResourcesSuccessfully(t)
+
+	generateOpts := opts()
+	generateOpts.RateCard = billing.RateCardFunc(func(l billing.Line) (float64, error) {
+		return l.Hours, nil
+	})
+
+	report, err := billing.Generate(context.TODO(), billing.Clients{Compute: serviceClient("compute")}, generateOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, len(report.Lines))
+	th.AssertEquals(t, 39.0, report.Total())
+}
+
+func TestGenerateInvalidPrice(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleResourcesSuccessfully(t)
+
+	generateOpts := opts()
+	generateOpts.RateCard = billing.StaticRateCard{FlavorPriceKey: "hw:cpu_policy"}
+
+	_, err := billing.Generate(context.TODO(), clients(), generateOpts)
+	th.AssertErr(t, err)
+}
+
+func TestGenerateInvalidOpts(t *testing.T) {
+	generateOpts := opts()
+	generateOpts.End = generateOpts.Start
+	_, err := billing.Generate(context.TODO(), clients(), generateOpts)
+	th.AssertErr(t, err)
+
+	generateOpts = opts()
+	generateOpts.RateCard = nil
+	_, err = billing.Generate(context.TODO(), clients(), generateOpts)
+	th.AssertErr(t, err)
+}
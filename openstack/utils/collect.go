@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// Collect walks every page of pager and returns the items extracted from
+// them with extract.
+func Collect[T any](ctx context.Context, pager pagination.Pager, extract func(pagination.Page) ([]T, error)) ([]T, error) {
+	var all []T
+	err := pager.EachPage(ctx, func(_ context.Context, page pagination.Page) (bool, error) {
+		items, err := extract(page)
+		if err != nil {
+			return false, err
+		}
+		all = append(all, items...)
+		return true, nil
+	})
+	return all, err
+}
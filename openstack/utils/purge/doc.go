@@ -0,0 +1,52 @@
+/*
+Package purge discovers every resource owned by a project and deletes them in
+dependency order.
+
+Resources are discovered with the List functions of each service and grouped
+into stages according to a dependency graph between resource types: load
+balancers before servers, servers before floating IPs, router interfaces
+before routers and subnets, and so on. A stage only starts once every
+resource of the previous stage is gone, so that asynchronous deletions (of
+servers, volumes, load balancers, ...) do not block the next stage.
+
+Services whose client is not set in Clients are not discovered. Swift
+containers are discovered in the account the ObjectStorage client is scoped
+to, which must be the account of the purged project: Discover returns an
+ErrAccountMismatch otherwise. DNS zones are listed across all projects and
+filtered on their project ID client-side.
+
+Example to List What Would Be Deleted
+
+	report, err := purge.Purge(context.TODO(), purge.Clients{
+		Compute: computeClient,
+		Network: networkClient,
+	}, purge.Opts{
+		ProjectID: "b9b4d5ad5d0d47e5b4b4c07f3b6f27d5",
+		DryRun:    true,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, o := range report.Outcomes {
+		fmt.Printf("stage %d: %s %s (%s)\n", o.Stage, o.Type, o.ID, o.Name)
+	}
+
+Example to Purge a Project
+
+	report, err := purge.Purge(context.TODO(), clients, purge.Opts{
+		ProjectID:    "b9b4d5ad5d0d47e5b4b4c07f3b6f27d5",
+		Concurrency:  10,
+		StageTimeout: 15 * time.Minute,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if err := report.Err(); err != nil {
+		for _, o := range report.Failed() {
+			fmt.Printf("%s %s: %v\n", o.Type, o.ID, o.Err)
+		}
+	}
+*/
+package purge
@@ -0,0 +1,98 @@
+package purge
+
+// order is the canonical order of resource types. It is used to sort the
+// types within a stage and to break ties in the dependency graph.
+var order = []ResourceType{
+	LoadBalancer,
+	Server,
+	FloatingIP,
+	RouterInterface,
+	Router,
+	Port,
+	Subnet,
+	Network,
+	PeeringConnection,
+	VPC,
+	Snapshot,
+	Volume,
+	Backup,
+	Image,
+	Container,
+	Zone,
+	Stack,
+}
+
+// dependencies maps each resource type to the types whose resources must be
+// deleted before it.
+var dependencies = map[ResourceType][]ResourceType{
+	LoadBalancer:      nil,
+	Server:            {LoadBalancer},
+	FloatingIP:        {Server},
+	RouterInterface:   {FloatingIP},
+	Router:            {RouterInterface},
+	Port:              {LoadBalancer, Server, RouterInterface},
+	Subnet:            {Port, RouterInterface},
+	Network:           {Subnet},
+	PeeringConnection: nil,
+	VPC:               {PeeringConnection, Router, Network},
+	Snapshot:          {Server},
+	Volume:            {Server, Snapshot},
+	Backup:            {Volume},
+	Image:             {Server},
+	Container:         nil,
+	Zone:              nil,
+	// Stacks may own any of the resources above; deleting them last leaves
+	// Heat only its own bookkeeping to clean up.
+	Stack: {LoadBalancer, Server, FloatingIP, RouterInterface, Router, Port, Subnet, Network, PeeringConnection, VPC, Snapshot, Volume, Backup, Image, Container, Zone},
+}
+
+// levels assigns every resource type to a stage: a type's stage is one more
+// than the highest stage of the types it depends on.
+func levels() map[ResourceType]int {
+	level := make(map[ResourceType]int, len(order))
+
+	var visit func(ResourceType) int
+	visit = func(t ResourceType) int {
+		if l, ok := level[t]; ok {
+			return l
+		}
+		l := 0
+		for _, dep := range dependencies[t] {
+			l = max(l, visit(dep)+1)
+		}
+		level[t] = l
+		return l
+	}
+
+	for _, t := range order {
+		visit(t)
+	}
+	return level
+}
+
+// stages groups resources into stages that can be deleted one after the
+// other. Stages without resources are omitted.
+func stages(found map[ResourceType][]Resource) []Stage {
+	level := levels()
+
+	var maxLevel int
+	for _, l := range level {
+		maxLevel = max(maxLevel, l)
+	}
+
+	var s []Stage
+	for l := 0; l <= maxLevel; l++ {
+		var stage Stage
+		for _, t := range order {
+			if level[t] != l || len(found[t]) == 0 {
+				continue
+			}
+			stage.Types = append(stage.Types, t)
+			stage.Resources = append(stage.Resources, found[t]...)
+		}
+		if len(stage.Resources) > 0 {
+			s = append(s, stage)
+		}
+	}
+	return s
+}
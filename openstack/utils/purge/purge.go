@@ -0,0 +1,356 @@
+package purge
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/batch"
+)
+
+// DefaultStageTimeout is the time a stage is given to delete its resources
+// when Opts.StageTimeout is not set.
+const DefaultStageTimeout = 10 * time.Minute
+
+// ResourceType identifies a kind of resource handled by Purge.
+type ResourceType string
+
+const (
+	LoadBalancer      ResourceType = "load_balancer"
+	Server            ResourceType = "server"
+	FloatingIP        ResourceType = "floating_ip"
+	RouterInterface   ResourceType = "router_interface"
+	Router            ResourceType = "router"
+	Port              ResourceType = "port"
+	Subnet            ResourceType = "subnet"
+	Network           ResourceType = "network"
+	PeeringConnection ResourceType = "peering_connection"
+	VPC               ResourceType = "vpc"
+	Snapshot          ResourceType = "snapshot"
+	Volume            ResourceType = "volume"
+	Backup            ResourceType = "backup"
+	Image             ResourceType = "image"
+	Container         ResourceType = "container"
+	Zone              ResourceType = "zone"
+	Stack             ResourceType = "stack"
+)
+
+// Clients holds the service clients used to discover and delete resources.
+// Resources of a service whose client is nil are not discovered.
+type Clients struct {
+	Compute       *gophercloud.ServiceClient
+	Network       *gophercloud.ServiceClient
+	BlockStorage  *gophercloud.ServiceClient
+	Image         *gophercloud.ServiceClient
+	ObjectStorage *gophercloud.ServiceClient
+	DNS           *gophercloud.ServiceClient
+	Orchestration *gophercloud.ServiceClient
+	LoadBalancer  *gophercloud.ServiceClient
+}
+
+// Opts configures Discover and Purge.
+type Opts struct {
+	// ProjectID is the project whose resources are purged.
+	ProjectID string
+
+	// Types restricts the purge to the given resource types. All types are
+	// handled when empty.
+	Types []ResourceType
+
+	// DryRun lists the resources that would be deleted without deleting them.
+	DryRun bool
+
+	// Concurrency is the number of resources of a type deleted at once.
+	// Defaults to batch.DefaultConcurrency.
+	Concurrency int
+
+	// StageTimeout bounds the time a stage may take, including waiting for
+	// asynchronous deletions to complete. Defaults to DefaultStageTimeout.
+	StageTimeout time.Duration
+
+	// ContinueOnError keeps going with the next stages after a resource failed
+	// to be deleted. By default, the remaining stages are skipped.
+	ContinueOnError bool
+}
+
+// Resource is a resource discovered in the project.
+type Resource struct {
+	Type ResourceType
+	ID   string
+	Name string
+
+	// ParentID is the ID of the resource this one belongs to, e.g. the router
+	// of a router interface or the name of a stack.
+	ParentID string
+}
+
+// Stage is a set of resources which can be deleted concurrently once every
+// previous stage is complete.
+type Stage struct {
+	Types     []ResourceType
+	Resources []Resource
+}
+
+// Plan is the ordered list of stages needed to purge a project.
+type Plan struct {
+	ProjectID string
+	Stages    []Stage
+}
+
+// Status is the outcome of purging a single resource.
+type Status string
+
+const (
+	// StatusPlanned is reported for every resource in a dry run.
+	StatusPlanned Status = "planned"
+
+	// StatusDeleted is reported for resources which are gone.
+	StatusDeleted Status = "deleted"
+
+	// StatusFailed is reported for resources which could not be deleted.
+	StatusFailed Status = "failed"
+
+	// StatusSkipped is reported for resources of stages which were not run
+	// because an earlier stage failed.
+	StatusSkipped Status = "skipped"
+)
+
+// Outcome is the result of purging a single resource.
+type Outcome struct {
+	Resource
+	Stage  int
+	Status Status
+	Err    error
+}
+
+// Report holds the outcome of every discovered resource, in deletion order.
+type Report struct {
+	ProjectID string
+	DryRun    bool
+	Outcomes  []Outcome
+}
+
+// Failed returns the outcomes of resources which could not be deleted.
+func (r Report) Failed() []Outcome {
+	var s []Outcome
+	for _, o := range r.Outcomes {
+		if o.Status == StatusFailed {
+			s = append(s, o)
+		}
+	}
+	return s
+}
+
+// Err returns an ErrPurge if any resource could not be deleted or was
+// skipped, and nil otherwise.
+func (r Report) Err() error {
+	var failed, skipped int
+	for _, o := range r.Outcomes {
+		switch o.Status {
+		case StatusFailed:
+			failed++
+		case StatusSkipped:
+			skipped++
+		}
+	}
+	if failed == 0 && skipped == 0 {
+		return nil
+	}
+	return ErrPurge{ProjectID: r.ProjectID, Failed: failed, Skipped: skipped}
+}
+
+// ErrPurge is returned by Report.Err when the purge did not complete.
+type ErrPurge struct {
+	gophercloud.BaseError
+	ProjectID string
+	Failed    int
+	Skipped   int
+}
+
+func (e ErrPurge) Error() string {
+	return fmt.Sprintf("Unable to purge project %s: %d resources failed, %d skipped", e.ProjectID, e.Failed, e.Skipped)
+}
+
+// ErrAccountMismatch is returned by Discover when the ObjectStorage client is
+// scoped to the account of another project than Opts.ProjectID, as deleting
+// its containers would wipe that project's data.
+type ErrAccountMismatch struct {
+	gophercloud.BaseError
+	Account   string
+	ProjectID string
+}
+
+func (e ErrAccountMismatch) Error() string {
+	return fmt.Sprintf("Object storage client is scoped to account %s, not to project %s", e.Account, e.ProjectID)
+}
+
+// Discover lists every resource of the project and arranges them into
+// stages.
+func Discover(ctx context.Context, clients Clients, opts Opts) (*Plan, error) {
+	if opts.ProjectID == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "purge.Opts.ProjectID"
+		return nil, err
+	}
+
+	wanted := make(map[ResourceType]bool, len(opts.Types))
+	for _, t := range opts.Types {
+		wanted[t] = true
+	}
+
+	// Discovery follows the canonical order, which lists VPCs before the
+	// peering connections filtered on them.
+	found := make(map[ResourceType][]Resource)
+	for _, t := range discoveryOrder {
+		h := handlers[t]
+		if h.client(clients) == nil {
+			continue
+		}
+		if len(wanted) > 0 && !wanted[t] && !needed(t, wanted) {
+			continue
+		}
+
+		resources, err := h.list(ctx, clients, opts.ProjectID, found)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list %ss: %w", t, err)
+		}
+		found[t] = resources
+	}
+
+	if len(wanted) > 0 {
+		for t := range found {
+			if !wanted[t] {
+				delete(found, t)
+			}
+		}
+	}
+
+	return &Plan{ProjectID: opts.ProjectID, Stages: stages(found)}, nil
+}
+
+// needed reports whether resources of type t are required to discover one of
+// the wanted types.
+func needed(t ResourceType, wanted map[ResourceType]bool) bool {
+	return t == VPC && wanted[PeeringConnection]
+}
+
+// Purge discovers the resources of the project and deletes them stage by
+// stage. In a dry run, the discovered resources are reported as planned and
+// nothing is deleted.
+//
+// The returned error is only set if discovery failed; failures to delete are
+// recorded in the Report.
+func Purge(ctx context.Context, clients Clients, opts Opts) (Report, error) {
+	plan, err := Discover(ctx, clients, opts)
+	if err != nil {
+		return Report{ProjectID: opts.ProjectID, DryRun: opts.DryRun}, err
+	}
+	return plan.Execute(ctx, clients, opts), nil
+}
+
+// Execute deletes the resources of the plan stage by stage.
+func (p *Plan) Execute(ctx context.Context, clients Clients, opts Opts) Report {
+	report := Report{ProjectID: p.ProjectID, DryRun: opts.DryRun}
+
+	timeout := opts.StageTimeout
+	if timeout <= 0 {
+		timeout = DefaultStageTimeout
+	}
+
+	var failed bool
+	for n, stage := range p.Stages {
+		outcomes := make([]Outcome, len(stage.Resources))
+		for i, r := range stage.Resources {
+			outcomes[i] = Outcome{Resource: r, Stage: n, Status: StatusPlanned}
+			if failed {
+				outcomes[i].Status = StatusSkipped
+			}
+		}
+
+		if !opts.DryRun && !failed {
+			stageCtx, cancel := context.WithTimeout(ctx, timeout)
+			p.runStage(stageCtx, clients, opts, outcomes)
+			cancel()
+
+			for _, o := range outcomes {
+				if o.Status != StatusDeleted && !opts.ContinueOnError {
+					failed = true
+				}
+			}
+		}
+
+		report.Outcomes = append(report.Outcomes, outcomes...)
+	}
+
+	return report
+}
+
+// runStage deletes the resources of a stage, one batch per resource type.
+func (p *Plan) runStage(ctx context.Context, clients Clients, opts Opts, outcomes []Outcome) {
+	byType := make(map[ResourceType][]int)
+	for i, o := range outcomes {
+		byType[o.Type] = append(byType[o.Type], i)
+	}
+
+	var wg sync.WaitGroup
+	for t, indexes := range byType {
+		h := handlers[t]
+		client := h.client(clients)
+
+		bopts := batch.Opts{Concurrency: opts.Concurrency}
+		if h.serial {
+			bopts.Concurrency = 1
+		}
+
+		ops := make([]batch.Operation, len(indexes))
+		for j, i := range indexes {
+			r := outcomes[i].Resource
+			ops[j] = batch.Operation{
+				Key:    r.ID,
+				Client: client,
+				Do: func(ctx context.Context, _ *gophercloud.ServiceClient) error {
+					return deleteAndWait(ctx, clients, h, r)
+				},
+			}
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results := batch.Run(ctx, ops, bopts)
+			for j, res := range results.Results {
+				o := &outcomes[indexes[j]]
+				switch {
+				case res.Skipped:
+					o.Status, o.Err = StatusFailed, ctx.Err()
+				case res.Err != nil:
+					o.Status, o.Err = StatusFailed, res.Err
+				default:
+					o.Status = StatusDeleted
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// deleteAndWait deletes r and, for resources deleted asynchronously, waits
+// until they are gone. Resources which no longer exist count as deleted.
+func deleteAndWait(ctx context.Context, clients Clients, h handler, r Resource) error {
+	if err := h.delete(ctx, clients, r); err != nil && !isNotFound(err) {
+		return err
+	}
+	if h.exists == nil {
+		return nil
+	}
+
+	return gophercloud.WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		ok, err := h.exists(ctx, clients, r)
+		if isNotFound(err) {
+			return true, nil
+		}
+		return !ok, err
+	})
+}
@@ -0,0 +1,387 @@
+package purge
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/batch"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/backups"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/snapshots"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/dns/v2/zones"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/image/v2/images"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/peeringconnections"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/subnets"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/vpcs"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/objectstorage/v1/containers"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/objectstorage/v1/objects"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/orchestration/v1/stacks"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// handler knows how to list and delete the resources of one type.
+type handler struct {
+	// client returns the service client of the resource type.
+	client func(Clients) *gophercloud.ServiceClient
+
+	// list returns the resources of the project. found holds the resources
+	// discovered so far.
+	list func(ctx context.Context, c Clients, projectID string, found map[ResourceType][]Resource) ([]Resource, error)
+
+	// delete requests the deletion of a resource.
+	delete func(ctx context.Context, c Clients, r Resource) error
+
+	// exists reports whether a resource which is deleted asynchronously still
+	// exists. It is nil for resources deleted synchronously.
+	exists func(ctx context.Context, c Clients, r Resource) (bool, error)
+
+	// serial deletes resources one at a time, in the order they were listed.
+	serial bool
+}
+
+// discoveryOrder lists VPCs before the peering connections filtered on them.
+var discoveryOrder = []ResourceType{
+	LoadBalancer,
+	Server,
+	FloatingIP,
+	RouterInterface,
+	Router,
+	Port,
+	Subnet,
+	Network,
+	VPC,
+	PeeringConnection,
+	Snapshot,
+	Volume,
+	Backup,
+	Image,
+	Container,
+	Zone,
+	Stack,
+}
+
+var handlers = map[ResourceType]handler{
+	LoadBalancer: {
+		client: func(c Clients) *gophercloud.ServiceClient { return c.LoadBalancer },
+		list: func(ctx context.Context, c Clients, projectID string, _ map[ResourceType][]Resource) ([]Resource, error) {
+			return collect(ctx, loadbalancers.List(c.LoadBalancer, loadbalancers.ListOpts{ProjectID: projectID}), loadbalancers.ExtractLoadBalancers, func(lb loadbalancers.LoadBalancer) (Resource, bool) {
+				return Resource{Type: LoadBalancer, ID: lb.ID, Name: lb.Name}, true
+			})
+		},
+		delete: func(ctx context.Context, c Clients, r Resource) error {
+			return loadbalancers.Delete(ctx, c.LoadBalancer, r.ID, loadbalancers.DeleteOpts{Cascade: true}).ExtractErr()
+		},
+		exists: func(ctx context.Context, c Clients, r Resource) (bool, error) {
+			return exists(loadbalancers.Get(ctx, c.LoadBalancer, r.ID).Err)
+		},
+	},
+	Server: {
+		client: func(c Clients) *gophercloud.ServiceClient { return c.Compute },
+		list: func(ctx context.Context, c Clients, projectID string, _ map[ResourceType][]Resource) ([]Resource, error) {
+			return collect(ctx, servers.List(c.Compute, servers.ListOpts{AllTenants: true, TenantID: projectID}), servers.ExtractServers, func(s servers.Server) (Resource, bool) {
+				return Resource{Type: Server, ID: s.ID, Name: s.Name}, true
+			})
+		},
+		delete: func(ctx context.Context, c Clients, r Resource) error {
+			return servers.Delete(ctx, c.Compute, r.ID).ExtractErr()
+		},
+		exists: func(ctx context.Context, c Clients, r Resource) (bool, error) {
+			return exists(servers.Get(ctx, c.Compute, r.ID).Err)
+		},
+	},
+	FloatingIP: {
+		client: func(c Clients) *gophercloud.ServiceClient { return c.Network },
+		list: func(ctx context.Context, c Clients, projectID string, _ map[ResourceType][]Resource) ([]Resource, error) {
+			return collect(ctx, floatingips.List(c.Network, floatingips.ListOpts{ProjectID: projectID}), floatingips.ExtractFloatingIPs, func(fip floatingips.FloatingIP) (Resource, bool) {
+				return Resource{Type: FloatingIP, ID: fip.ID, Name: fip.FloatingIP}, true
+			})
+		},
+		delete: func(ctx context.Context, c Clients, r Resource) error {
+			return floatingips.Delete(ctx, c.Network, r.ID).ExtractErr()
+		},
+	},
+	RouterInterface: {
+		client: func(c Clients) *gophercloud.ServiceClient { return c.Network },
+		list: func(ctx context.Context, c Clients, projectID string, _ map[ResourceType][]Resource) ([]Resource, error) {
+			return collect(ctx, ports.List(c.Network, ports.ListOpts{ProjectID: projectID}), ports.ExtractPorts, func(p ports.Port) (Resource, bool) {
+				return Resource{Type: RouterInterface, ID: p.ID, Name: p.Name, ParentID: p.DeviceID}, p.IsRouterInterface()
+			})
+		},
+		delete: func(ctx context.Context, c Clients, r Resource) error {
+			_, err := routers.RemoveInterface(ctx, c.Network, r.ParentID, routers.RemoveInterfaceOpts{PortID: r.ID}).Extract()
+			return err
+		},
+	},
+	Router: {
+		client: func(c Clients) *gophercloud.ServiceClient { return c.Network },
+		list: func(ctx context.Context, c Clients, projectID string, _ map[ResourceType][]Resource) ([]Resource, error) {
+			return collect(ctx, routers.List(c.Network, routers.ListOpts{ProjectID: projectID}), routers.ExtractRouters, func(r routers.Router) (Resource, bool) {
+				return Resource{Type: Router, ID: r.ID, Name: r.Name}, true
+			})
+		},
+		delete: func(ctx context.Context, c Clients, r Resource) error {
+			return routers.Delete(ctx, c.Network, r.ID).ExtractErr()
+		},
+	},
+	Port: {
+		client: func(c Clients) *gophercloud.ServiceClient { return c.Network },
+		list: func(ctx context.Context, c Clients, projectID string, _ map[ResourceType][]Resource) ([]Resource, error) {
+			// Ports owned by Neutron itself (DHCP, router gateways, floating
+			// IPs and router interfaces) go away with their owner.
+			return collect(ctx, ports.List(c.Network, ports.ListOpts{ProjectID: projectID}), ports.ExtractPorts, func(p ports.Port) (Resource, bool) {
+				return Resource{Type: Port, ID: p.ID, Name: p.Name}, !strings.HasPrefix(p.DeviceOwner, "network:")
+			})
+		},
+		delete: func(ctx context.Context, c Clients, r Resource) error {
+			return ports.Delete(ctx, c.Network, r.ID).ExtractErr()
+		},
+	},
+	Subnet: {
+		client: func(c Clients) *gophercloud.ServiceClient { return c.Network },
+		list: func(ctx context.Context, c Clients, projectID string, _ map[ResourceType][]Resource) ([]Resource, error) {
+			return collect(ctx, subnets.List(c.Network, subnets.ListOpts{ProjectID: projectID}), subnets.ExtractSubnets, func(s subnets.Subnet) (Resource, bool) {
+				return Resource{Type: Subnet, ID: s.ID, Name: s.Name}, true
+			})
+		},
+		delete: func(ctx context.Context, c Clients, r Resource) error {
+			return subnets.Delete(ctx, c.Network, r.ID).ExtractErr()
+		},
+	},
+	Network: {
+		client: func(c Clients) *gophercloud.ServiceClient { return c.Network },
+		list: func(ctx context.Context, c Clients, projectID string, _ map[ResourceType][]Resource) ([]Resource, error) {
+			return collect(ctx, networks.List(c.Network, networks.ListOpts{ProjectID: projectID}), networks.ExtractNetworks, func(n networks.Network) (Resource, bool) {
+				return Resource{Type: Network, ID: n.ID, Name: n.Name}, true
+			})
+		},
+		delete: func(ctx context.Context, c Clients, r Resource) error {
+			return networks.Delete(ctx, c.Network, r.ID).ExtractErr()
+		},
+	},
+	VPC: {
+		client: func(c Clients) *gophercloud.ServiceClient { return c.Network },
+		list: func(ctx context.Context, c Clients, projectID string, _ map[ResourceType][]Resource) ([]Resource, error) {
+			return collect(ctx, vpcs.List(c.Network, vpcs.ListOpts{ProjectID: projectID}), vpcs.ExtractVPCs, func(v vpcs.VPC) (Resource, bool) {
+				return Resource{Type: VPC, ID: v.ID, Name: v.Name}, true
+			})
+		},
+		delete: func(ctx context.Context, c Clients, r Resource) error {
+			return vpcs.Delete(ctx, c.Network, r.ID).ExtractErr()
+		},
+	},
+	PeeringConnection: {
+		client: func(c Clients) *gophercloud.ServiceClient { return c.Network },
+		list: func(ctx context.Context, c Clients, _ string, found map[ResourceType][]Resource) ([]Resource, error) {
+			// Peering connections carry no project; keep those which
+			// involve one of the project's VPCs.
+			owned := make(map[string]bool, len(found[VPC]))
+			for _, v := range found[VPC] {
+				owned[v.ID] = true
+			}
+			return collect(ctx, peeringconnections.List(c.Network, nil), peeringconnections.ExtractPeeringConnections, func(p peeringconnections.PeeringConnection) (Resource, bool) {
+				return Resource{Type: PeeringConnection, ID: p.ID, Name: p.Description}, owned[p.VpcId] || owned[p.PeerVpcId]
+			})
+		},
+		delete: func(ctx context.Context, c Clients, r Resource) error {
+			return peeringconnections.Delete(ctx, c.Network, r.ID).ExtractErr()
+		},
+	},
+	Snapshot: {
+		client: func(c Clients) *gophercloud.ServiceClient { return c.BlockStorage },
+		list: func(ctx context.Context, c Clients, projectID string, _ map[ResourceType][]Resource) ([]Resource, error) {
+			return collect(ctx, snapshots.List(c.BlockStorage, snapshots.ListOpts{AllTenants: true, TenantID: projectID}), snapshots.ExtractSnapshots, func(s snapshots.Snapshot) (Resource, bool) {
+				return Resource{Type: Snapshot, ID: s.ID, Name: s.Name}, true
+			})
+		},
+		delete: func(ctx context.Context, c Clients, r Resource) error {
+			return snapshots.Delete(ctx, c.BlockStorage, r.ID).ExtractErr()
+		},
+		exists: func(ctx context.Context, c Clients, r Resource) (bool, error) {
+			return exists(snapshots.Get(ctx, c.BlockStorage, r.ID).Err)
+		},
+	},
+	Volume: {
+		client: func(c Clients) *gophercloud.ServiceClient { return c.BlockStorage },
+		list: func(ctx context.Context, c Clients, projectID string, _ map[ResourceType][]Resource) ([]Resource, error) {
+			return collect(ctx, volumes.List(c.BlockStorage, volumes.ListOpts{AllTenants: true, TenantID: projectID}), volumes.ExtractVolumes, func(v volumes.Volume) (Resource, bool) {
+				return Resource{Type: Volume, ID: v.ID, Name: v.Name}, true
+			})
+		},
+		delete: func(ctx context.Context, c Clients, r Resource) error {
+			return volumes.Delete(ctx, c.BlockStorage, r.ID, volumes.DeleteOpts{Cascade: true}).ExtractErr()
+		},
+		exists: func(ctx context.Context, c Clients, r Resource) (bool, error) {
+			return exists(volumes.Get(ctx, c.BlockStorage, r.ID).Err)
+		},
+	},
+	Backup: {
+		client: func(c Clients) *gophercloud.ServiceClient { return c.BlockStorage },
+		list: func(ctx context.Context, c Clients, projectID string, _ map[ResourceType][]Resource) ([]Resource, error) {
+			var all []backups.Backup
+			err := backups.ListDetail(c.BlockStorage, backups.ListDetailOpts{AllTenants: true, TenantID: projectID}).EachPage(ctx, func(_ context.Context, page pagination.Page) (bool, error) {
+				b, err := backups.ExtractBackups(page)
+				all = append(all, b...)
+				return true, err
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			// Incremental backups must be deleted before the backups they
+			// are based on, so the newest go first.
+			sort.SliceStable(all, func(i, j int) bool { return all[i].CreatedAt.After(all[j].CreatedAt) })
+
+			resources := make([]Resource, len(all))
+			for i, b := range all {
+				resources[i] = Resource{Type: Backup, ID: b.ID, Name: b.Name}
+			}
+			return resources, nil
+		},
+		delete: func(ctx context.Context, c Clients, r Resource) error {
+			return backups.Delete(ctx, c.BlockStorage, r.ID).ExtractErr()
+		},
+		exists: func(ctx context.Context, c Clients, r Resource) (bool, error) {
+			return exists(backups.Get(ctx, c.BlockStorage, r.ID).Err)
+		},
+		serial: true,
+	},
+	Image: {
+		client: func(c Clients) *gophercloud.ServiceClient { return c.Image },
+		list: func(ctx context.Context, c Clients, projectID string, _ map[ResourceType][]Resource) ([]Resource, error) {
+			return collect(ctx, images.List(c.Image, images.ListOpts{Owner: projectID}), images.ExtractImages, func(i images.Image) (Resource, bool) {
+				return Resource{Type: Image, ID: i.ID, Name: i.Name}, true
+			})
+		},
+		delete: func(ctx context.Context, c Clients, r Resource) error {
+			return images.Delete(ctx, c.Image, r.ID).ExtractErr()
+		},
+	},
+	Container: {
+		client: func(c Clients) *gophercloud.ServiceClient { return c.ObjectStorage },
+		list: func(ctx context.Context, c Clients, projectID string, _ map[ResourceType][]Resource) ([]Resource, error) {
+			// Containers can only be listed in the account of the client,
+			// which must therefore belong to the project.
+			if account := swiftAccount(c.ObjectStorage.Endpoint); account != projectID && !strings.HasSuffix(account, "_"+projectID) {
+				return nil, ErrAccountMismatch{Account: account, ProjectID: projectID}
+			}
+			return collect(ctx, containers.List(c.ObjectStorage, nil), containers.ExtractInfo, func(ct containers.Container) (Resource, bool) {
+				return Resource{Type: Container, ID: ct.Name, Name: ct.Name}, true
+			})
+		},
+		delete: func(ctx context.Context, c Clients, r Resource) error {
+			// A container must be empty before it can be deleted.
+			pages, err := objects.List(c.ObjectStorage, r.ID, nil).AllPages(ctx)
+			if err != nil {
+				return err
+			}
+			names, err := objects.ExtractNames(pages)
+			if err != nil {
+				return err
+			}
+			if err := batch.DeleteObjects(ctx, c.ObjectStorage, r.ID, names, batch.Opts{}).Err(); err != nil {
+				return err
+			}
+			return containers.Delete(ctx, c.ObjectStorage, r.ID).Err
+		},
+	},
+	Zone: {
+		client: func(c Clients) *gophercloud.ServiceClient { return c.DNS },
+		list: func(ctx context.Context, c Clients, projectID string, _ map[ResourceType][]Resource) ([]Resource, error) {
+			return collect(ctx, zones.List(allProjects(c.DNS), nil), zones.ExtractZones, func(z zones.Zone) (Resource, bool) {
+				return Resource{Type: Zone, ID: z.ID, Name: z.Name}, z.ProjectID == projectID
+			})
+		},
+		delete: func(ctx context.Context, c Clients, r Resource) error {
+			return zones.Delete(ctx, allProjects(c.DNS), r.ID).Err
+		},
+		exists: func(ctx context.Context, c Clients, r Resource) (bool, error) {
+			return exists(zones.Get(ctx, allProjects(c.DNS), r.ID).Err)
+		},
+	},
+	Stack: {
+		client: func(c Clients) *gophercloud.ServiceClient { return c.Orchestration },
+		list: func(ctx context.Context, c Clients, projectID string, _ map[ResourceType][]Resource) ([]Resource, error) {
+			return collect(ctx, stacks.List(c.Orchestration, stacks.ListOpts{TenantID: projectID}), stacks.ExtractStacks, func(s stacks.ListedStack) (Resource, bool) {
+				return Resource{Type: Stack, ID: s.ID, Name: s.Name, ParentID: s.Name}, true
+			})
+		},
+		delete: func(ctx context.Context, c Clients, r Resource) error {
+			return stacks.Delete(ctx, c.Orchestration, r.ParentID, r.ID).ExtractErr()
+		},
+		exists: func(ctx context.Context, c Clients, r Resource) (bool, error) {
+			// Deleted stacks remain visible for a while in DELETE_COMPLETE.
+			s, err := stacks.Get(ctx, c.Orchestration, r.ParentID, r.ID).Extract()
+			if err != nil {
+				return exists(err)
+			}
+			switch s.Status {
+			case "DELETE_COMPLETE":
+				return false, nil
+			case "DELETE_FAILED":
+				return true, fmt.Errorf("stack %s: %s", s.Status, s.StatusReason)
+			}
+			return true, nil
+		},
+	},
+}
+
+// swiftAccount returns the account of an object storage endpoint, e.g.
+// AUTH_<project_id> for https://swift.example.com/v1/AUTH_<project_id>/.
+func swiftAccount(endpoint string) string {
+	return path.Base(strings.TrimSuffix(endpoint, "/"))
+}
+
+// allProjects returns a copy of a DNS client which sees the zones of every
+// project.
+func allProjects(client *gophercloud.ServiceClient) *gophercloud.ServiceClient {
+	c := *client
+	c.MoreHeaders = map[string]string{"X-Auth-All-Projects": "true"}
+	for k, v := range client.MoreHeaders {
+		c.MoreHeaders[k] = v
+	}
+	return &c
+}
+
+// collect lists every item of pager and converts those that keep reports
+// true for into resources.
+func collect[T any](ctx context.Context, pager pagination.Pager, extract func(pagination.Page) ([]T, error), convert func(T) (Resource, bool)) ([]Resource, error) {
+	items, err := utils.Collect(ctx, pager, extract)
+	if err != nil {
+		return nil, err
+	}
+	var resources []Resource
+	for _, item := range items {
+		if r, keep := convert(item); keep {
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+func isNotFound(err error) bool {
+	return gophercloud.ResponseCodeIs(err, http.StatusNotFound)
+}
+
+// exists interprets the error of a Get request: a 404 means the resource is
+// gone.
+func exists(err error) (bool, error) {
+	if err == nil {
+		return true, nil
+	}
+	if isNotFound(err) {
+		return false, nil
+	}
+	return false, err
+}
@@ -0,0 +1,2 @@
+// purge unit tests
+package testing
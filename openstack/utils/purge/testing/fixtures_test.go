@@ -0,0 +1,185 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const projectID = "9fe1d3d7e6ae4fd3b7c5c11ab5d76f7d"
+
+const ListPortsResponse = `
+{
+    "ports": [
+        {
+            "id": "2f5c4d1d-7a8b-4e0b-a7d4-46f2d1b3a5a1",
+            "name": "",
+            "device_owner": "network:router_interface",
+            "device_id": "f8a44de0-fc8e-45df-93c7-f79bf3b01c95"
+        },
+        {
+            "id": "b8a3f0c1-49a2-4bd5-9a8f-4ac5cb3c1e58",
+            "name": "app-port",
+            "device_owner": "compute:nova",
+            "device_id": "0b0e9b7c-0f57-4b04-93b5-f3a0e1f0e3f4"
+        },
+        {
+            "id": "d5f1c3b0-8a6a-4e0a-a6f2-5d3e3e9a9d77",
+            "name": "",
+            "device_owner": "network:dhcp",
+            "device_id": "dhcp"
+        }
+    ]
+}
+`
+
+const ListRoutersResponse = `
+{
+    "routers": [
+        {
+            "id": "f8a44de0-fc8e-45df-93c7-f79bf3b01c95",
+            "name": "router1"
+        }
+    ]
+}
+`
+
+const ListSubnetsResponse = `
+{
+    "subnets": [
+        {
+            "id": "08eae331-0402-425a-923c-34f7cfe39c1b",
+            "name": "subnet1"
+        }
+    ]
+}
+`
+
+const ListNetworksResponse = `
+{
+    "networks": [
+        {
+            "id": "db193ab3-96e3-4cb3-8fc5-05f4296d0324",
+            "name": "net1"
+        }
+    ]
+}
+`
+
+const ListVPCsResponse = `
+{
+    "vpcs": [
+        {
+            "id": "5b6e4c0e-6f3a-4c0b-8a5a-0f9e4b7c2d11",
+            "name": "vpc1"
+        }
+    ]
+}
+`
+
+const ListPeeringConnectionsResponse = `
+{
+    "peering_connections": [
+        {
+            "id": "3c7f1a52-5e3b-4c6a-9b1f-0d3e6f1a2b44",
+            "description": "to-shared",
+            "src_vpc_id": "5b6e4c0e-6f3a-4c0b-8a5a-0f9e4b7c2d11",
+            "dest_vpc_id": "e2d4a6b8-1c3e-4f5a-8b7c-9d0e1f2a3b4c"
+        },
+        {
+            "id": "7d8e9f0a-1b2c-4d3e-8f4a-5b6c7d8e9f0a",
+            "description": "unrelated",
+            "src_vpc_id": "11111111-2222-4333-8444-555555555555",
+            "dest_vpc_id": "e2d4a6b8-1c3e-4f5a-8b7c-9d0e1f2a3b4c"
+        }
+    ]
+}
+`
+
+// deletions records the DELETE and remove_router_interface requests in the
+// order they were received.
+type deletions struct {
+	mu   sync.Mutex
+	urls []string
+}
+
+func (d *deletions) add(url string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.urls = append(d.urls, url)
+}
+
+func handleList(t *testing.T, path, body string, d *deletions) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestMethod(t, r, "GET")
+		if path != "/v2.0/peering-connections" {
+			th.TestFormValues(t, r, map[string]string{"project_id": projectID})
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, body)
+	})
+
+	th.Mux.HandleFunc(path+"/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		if r.Method == "PUT" {
+			d.add("PUT " + r.URL.Path)
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprint(w, `{"id": "f8a44de0-fc8e-45df-93c7-f79bf3b01c95"}`)
+			return
+		}
+
+		th.TestMethod(t, r, "DELETE")
+		d.add("DELETE " + r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleNetworkResources registers the networking resources of the project.
+func HandleNetworkResources(t *testing.T, d *deletions) {
+	handleList(t, "/v2.0/ports", ListPortsResponse, d)
+	handleList(t, "/v2.0/routers", ListRoutersResponse, d)
+	handleList(t, "/v2.0/subnets", ListSubnetsResponse, d)
+	handleList(t, "/v2.0/networks", ListNetworksResponse, d)
+	handleList(t, "/v2.0/vpcs", ListVPCsResponse, d)
+	handleList(t, "/v2.0/floatingips", `{"floatingips": []}`, d)
+	handleList(t, "/v2.0/peering-connections", ListPeeringConnectionsResponse, d)
+}
+
+// ListZonesResponse holds zones of the project and of another one.
+const ListZonesResponse = `
+{
+    "zones": [
+        {
+            "id": "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3",
+            "name": "example.org.",
+            "project_id": "9fe1d3d7e6ae4fd3b7c5c11ab5d76f7d"
+        },
+        {
+            "id": "0e1c4b4d-0f5f-4e8c-9d86-0a1b2c3d4e5f",
+            "name": "other.org.",
+            "project_id": "4fd44f30292945e481c7b8a0c8908869"
+        }
+    ]
+}
+`
+
+// HandleListZones lists the zones of every project, which requires the
+// all-projects header.
+func HandleListZones(t *testing.T) {
+	th.Mux.HandleFunc("/zones", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "X-Auth-All-Projects", "true")
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListZonesResponse)
+	})
+}
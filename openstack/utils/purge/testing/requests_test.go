@@ -0,0 +1,143 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils/purge"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+func TestDiscover(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleNetworkResources(t, &deletions{})
+
+	plan, err := purge.Discover(context.TODO(), purge.Clients{Network: common.ServiceClient()}, purge.Opts{ProjectID: projectID})
+	th.AssertNoErr(t, err)
+
+	expected := []purge.Stage{
+		{
+			Types: []purge.ResourceType{purge.PeeringConnection},
+			Resources: []purge.Resource{
+				{Type: purge.PeeringConnection, ID: "3c7f1a52-5e3b-4c6a-9b1f-0d3e6f1a2b44", Name: "to-shared"},
+			},
+		},
+		{
+			Types: []purge.ResourceType{purge.RouterInterface},
+			Resources: []purge.Resource{
+				{Type: purge.RouterInterface, ID: "2f5c4d1d-7a8b-4e0b-a7d4-46f2d1b3a5a1", ParentID: "f8a44de0-fc8e-45df-93c7-f79bf3b01c95"},
+			},
+		},
+		{
+			Types: []purge.ResourceType{purge.Router, purge.Port},
+			Resources: []purge.Resource{
+				{Type: purge.Router, ID: "f8a44de0-fc8e-45df-93c7-f79bf3b01c95", Name: "router1"},
+				{Type: purge.Port, ID: "b8a3f0c1-49a2-4bd5-9a8f-4ac5cb3c1e58", Name: "app-port"},
+			},
+		},
+		{
+			Types: []purge.ResourceType{purge.Subnet},
+			Resources: []purge.Resource{
+				{Type: purge.Subnet, ID: "08eae331-0402-425a-923c-34f7cfe39c1b", Name: "subnet1"},
+			},
+		},
+		{
+			Types: []purge.ResourceType{purge.Network},
+			Resources: []purge.Resource{
+				{Type: purge.Network, ID: "db193ab3-96e3-4cb3-8fc5-05f4296d0324", Name: "net1"},
+			},
+		},
+		{
+			Types: []purge.ResourceType{purge.VPC},
+			Resources: []purge.Resource{
+				{Type: purge.VPC, ID: "5b6e4c0e-6f3a-4c0b-8a5a-0f9e4b7c2d11", Name: "vpc1"},
+			},
+		},
+	}
+	th.AssertDeepEquals(t, expected, plan.Stages)
+}
+
+func TestPurgeDryRun(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	d := &deletions{}
+	HandleNetworkResources(t, d)
+
+	report, err := purge.Purge(context.TODO(), purge.Clients{Network: common.ServiceClient()}, purge.Opts{
+		ProjectID: projectID,
+		DryRun:    true,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, report.Err())
+	th.AssertEquals(t, 7, len(report.Outcomes))
+	for _, o := range report.Outcomes {
+		th.AssertEquals(t, purge.StatusPlanned, o.Status)
+	}
+	th.AssertEquals(t, 0, len(d.urls))
+}
+
+func TestPurge(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	d := &deletions{}
+	HandleNetworkResources(t, d)
+
+	report, err := purge.Purge(context.TODO(), purge.Clients{Network: common.ServiceClient()}, purge.Opts{
+		ProjectID: projectID,
+		Types:     []purge.ResourceType{purge.RouterInterface, purge.Router, purge.Subnet, purge.Network},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, report.Err())
+	th.AssertEquals(t, 4, len(report.Outcomes))
+	for _, o := range report.Outcomes {
+		th.AssertEquals(t, purge.StatusDeleted, o.Status)
+	}
+
+	th.AssertDeepEquals(t, []string{
+		"PUT /v2.0/routers/f8a44de0-fc8e-45df-93c7-f79bf3b01c95/remove_router_interface",
+		"DELETE /v2.0/routers/f8a44de0-fc8e-45df-93c7-f79bf3b01c95",
+		"DELETE /v2.0/subnets/08eae331-0402-425a-923c-34f7cfe39c1b",
+		"DELETE /v2.0/networks/db193ab3-96e3-4cb3-8fc5-05f4296d0324",
+	}, d.urls)
+}
+
+func TestDiscoverRequiresProjectID(t *testing.T) {
+	_, err := purge.Discover(context.TODO(), purge.Clients{}, purge.Opts{})
+	if err == nil {
+		t.Fatal("Expected an error without a project ID")
+	}
+}
+
+func TestDiscoverZones(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListZones(t)
+
+	plan, err := purge.Discover(context.TODO(), purge.Clients{DNS: fake.ServiceClient()}, purge.Opts{ProjectID: projectID})
+	th.AssertNoErr(t, err)
+
+	expected := []purge.Stage{
+		{
+			Types: []purge.ResourceType{purge.Zone},
+			Resources: []purge.Resource{
+				{Type: purge.Zone, ID: "a86dba58-0043-4cc6-a1bb-69d5e86f3ca3", Name: "example.org."},
+			},
+		},
+	}
+	th.AssertDeepEquals(t, expected, plan.Stages)
+}
+
+func TestDiscoverRefusesForeignAccount(t *testing.T) {
+	client := fake.ServiceClient()
+	client.Endpoint = "https://swift.example.com/v1/AUTH_4fd44f30292945e481c7b8a0c8908869/"
+
+	_, err := purge.Discover(context.TODO(), purge.Clients{ObjectStorage: client}, purge.Opts{ProjectID: projectID})
+
+	var errAccount purge.ErrAccountMismatch
+	th.AssertEquals(t, true, errors.As(err, &errAccount))
+	th.AssertEquals(t, "AUTH_4fd44f30292945e481c7b8a0c8908869", errAccount.Account)
+}
@@ -0,0 +1,36 @@
+/*
+Package quota checks whether a planned deployment fits in the remaining quota
+of a project before anything is provisioned.
+
+Preflight collects the quota and usage of the compute, networking, block
+storage and load balancer services, computes the headroom of each resource and
+compares it with the requested demand. Services whose client is not set in
+Clients are not queried; a demand on one of their resources is an error.
+
+Example to Check a Deployment
+
+	report, err := quota.Preflight(context.TODO(), quota.Clients{
+		Compute:      computeClient,
+		Network:      networkClient,
+		BlockStorage: blockStorageClient,
+		LoadBalancer: lbClient,
+	}, quota.Demand{
+		ProjectID:     "6a9b4c3f0b2e4f3c9c1d8e7f6a5b4c3d",
+		Cores:         16,
+		RAM:           32768,
+		Instances:     4,
+		Ports:         8,
+		FloatingIPs:   2,
+		Volumes:       4,
+		Gigabytes:     400,
+		LoadBalancers: 1,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, s := range report.Shortfalls() {
+		fmt.Printf("%s: need %d, %d available\n", s.Resource, s.Requested, s.Headroom)
+	}
+*/
+package quota
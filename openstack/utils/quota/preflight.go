@@ -0,0 +1,348 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	blockstoragequotasets "github.com/vnpaycloud-console/gophercloud/v2/openstack/blockstorage/v3/quotasets"
+	computelimits "github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/limits"
+	computequotasets "github.com/vnpaycloud-console/gophercloud/v2/openstack/compute/v2/quotasets"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	lbquotas "github.com/vnpaycloud-console/gophercloud/v2/openstack/loadbalancer/v2/quotas"
+	networkquotas "github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/extensions/quotas"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// Resource identifies a quota-limited resource.
+type Resource string
+
+const (
+	Cores         Resource = "cores"
+	RAM           Resource = "ram"
+	Instances     Resource = "instances"
+	Ports         Resource = "ports"
+	FloatingIPs   Resource = "floating_ips"
+	Volumes       Resource = "volumes"
+	Gigabytes     Resource = "gigabytes"
+	LoadBalancers Resource = "load_balancers"
+)
+
+// Clients holds the service clients queried by Preflight.
+type Clients struct {
+	Compute      *gophercloud.ServiceClient
+	Network      *gophercloud.ServiceClient
+	BlockStorage *gophercloud.ServiceClient
+	LoadBalancer *gophercloud.ServiceClient
+}
+
+// Demand is the amount of resources a deployment needs.
+type Demand struct {
+	// ProjectID is the project the resources are provisioned in.
+	ProjectID string
+
+	Cores     int
+	RAM       int // megabytes
+	Instances int
+
+	Ports       int
+	FloatingIPs int
+
+	Volumes   int
+	Gigabytes int
+
+	LoadBalancers int
+}
+
+func (d Demand) requested() map[Resource]int {
+	return map[Resource]int{
+		Cores:         d.Cores,
+		RAM:           d.RAM,
+		Instances:     d.Instances,
+		Ports:         d.Ports,
+		FloatingIPs:   d.FloatingIPs,
+		Volumes:       d.Volumes,
+		Gigabytes:     d.Gigabytes,
+		LoadBalancers: d.LoadBalancers,
+	}
+}
+
+// resources lists the resources in the order they are reported.
+var resources = []Resource{Cores, RAM, Instances, Ports, FloatingIPs, Volumes, Gigabytes, LoadBalancers}
+
+// Usage is the quota and current usage of a resource.
+type Usage struct {
+	// Limit is the quota of the resource. A value of -1 means no limit.
+	Limit int
+
+	// InUse is the amount currently provisioned.
+	InUse int
+
+	// Reserved is the amount claimed by in-flight requests.
+	Reserved int
+}
+
+// Unlimited reports whether the resource has no quota.
+func (u Usage) Unlimited() bool {
+	return u.Limit < 0
+}
+
+// Headroom returns the amount of the resource which can still be allocated,
+// or -1 if the resource is unlimited.
+func (u Usage) Headroom() int {
+	if u.Unlimited() {
+		return -1
+	}
+	return max(u.Limit-u.InUse-u.Reserved, 0)
+}
+
+// Item is the preflight check of a single resource.
+type Item struct {
+	Resource Resource
+	Usage    Usage
+
+	// Requested is the amount of the resource in the demand.
+	Requested int
+
+	// Headroom is the amount still available, or -1 if unlimited.
+	Headroom int
+
+	// Shortfall is the amount by which the demand exceeds the headroom.
+	Shortfall int
+}
+
+// Report is the result of a preflight check.
+type Report struct {
+	ProjectID string
+	Items     []Item
+}
+
+// Shortfalls returns the resources for which the demand exceeds the headroom.
+func (r Report) Shortfalls() []Item {
+	var s []Item
+	for _, item := range r.Items {
+		if item.Shortfall > 0 {
+			s = append(s, item)
+		}
+	}
+	return s
+}
+
+// Fits reports whether the whole demand fits in the remaining quota.
+func (r Report) Fits() bool {
+	return len(r.Shortfalls()) == 0
+}
+
+// Err returns an ErrQuotaExceeded if the demand does not fit, and nil
+// otherwise.
+func (r Report) Err() error {
+	if s := r.Shortfalls(); len(s) > 0 {
+		return ErrQuotaExceeded{ProjectID: r.ProjectID, Shortfalls: s}
+	}
+	return nil
+}
+
+// ErrQuotaExceeded is returned by Report.Err when a demand does not fit in
+// the remaining quota.
+type ErrQuotaExceeded struct {
+	gophercloud.BaseError
+	ProjectID  string
+	Shortfalls []Item
+}
+
+func (e ErrQuotaExceeded) Error() string {
+	parts := make([]string, len(e.Shortfalls))
+	for i, s := range e.Shortfalls {
+		parts[i] = fmt.Sprintf("%s (requested %d, available %d)", s.Resource, s.Requested, s.Headroom)
+	}
+	return fmt.Sprintf("Quota exceeded in project %s: %s", e.ProjectID, strings.Join(parts, ", "))
+}
+
+// collector gathers the usage of the resources of one service.
+type collector struct {
+	resources []Resource
+	client    func(Clients) *gophercloud.ServiceClient
+	collect   func(ctx context.Context, client *gophercloud.ServiceClient, projectID string) (map[Resource]Usage, error)
+}
+
+var collectors = []collector{
+	{
+		resources: []Resource{Cores, RAM, Instances},
+		client:    func(c Clients) *gophercloud.ServiceClient { return c.Compute },
+		collect:   collectCompute,
+	},
+	{
+		resources: []Resource{Ports, FloatingIPs},
+		client:    func(c Clients) *gophercloud.ServiceClient { return c.Network },
+		collect:   collectNetwork,
+	},
+	{
+		resources: []Resource{Volumes, Gigabytes},
+		client:    func(c Clients) *gophercloud.ServiceClient { return c.BlockStorage },
+		collect:   collectBlockStorage,
+	},
+	{
+		resources: []Resource{LoadBalancers},
+		client:    func(c Clients) *gophercloud.ServiceClient { return c.LoadBalancer },
+		collect:   collectLoadBalancer,
+	},
+}
+
+// Preflight collects the quota and usage of every service with a client in
+// clients, in parallel, and checks demand against the remaining headroom.
+//
+// The returned error is set if usage could not be collected; a demand which
+// does not fit is reported through Report.Shortfalls and Report.Err.
+func Preflight(ctx context.Context, clients Clients, demand Demand) (*Report, error) {
+	if demand.ProjectID == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "quota.Demand.ProjectID"
+		return nil, err
+	}
+
+	requested := demand.requested()
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		usages = make(map[Resource]Usage)
+		errs   []error
+	)
+
+	for _, c := range collectors {
+		if c.client(clients) != nil {
+			continue
+		}
+		for _, res := range c.resources {
+			if requested[res] > 0 {
+				return nil, fmt.Errorf("no client provided to check the %s quota", res)
+			}
+		}
+	}
+
+	for _, c := range collectors {
+		client := c.client(clients)
+		if client == nil {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			u, err := c.collect(ctx, client, demand.ProjectID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			for res, usage := range u {
+				usages[res] = usage
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	report := &Report{ProjectID: demand.ProjectID}
+	for _, res := range resources {
+		usage, ok := usages[res]
+		if !ok {
+			continue
+		}
+
+		item := Item{
+			Resource:  res,
+			Usage:     usage,
+			Requested: requested[res],
+			Headroom:  usage.Headroom(),
+		}
+		if !usage.Unlimited() && item.Requested > item.Headroom {
+			item.Shortfall = item.Requested - item.Headroom
+		}
+		report.Items = append(report.Items, item)
+	}
+
+	return report, nil
+}
+
+// collectCompute uses the detailed quota set, which includes reservations,
+// and falls back to the absolute limits when it is forbidden or not found.
+func collectCompute(ctx context.Context, client *gophercloud.ServiceClient, projectID string) (map[Resource]Usage, error) {
+	q, err := computequotasets.GetDetail(ctx, client, projectID).Extract()
+	if err == nil {
+		return map[Resource]Usage{
+			Cores:     {Limit: q.Cores.Limit, InUse: q.Cores.InUse, Reserved: q.Cores.Reserved},
+			RAM:       {Limit: q.RAM.Limit, InUse: q.RAM.InUse, Reserved: q.RAM.Reserved},
+			Instances: {Limit: q.Instances.Limit, InUse: q.Instances.InUse, Reserved: q.Instances.Reserved},
+		}, nil
+	}
+	if !gophercloud.ResponseCodeIs(err, http.StatusForbidden) && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+		return nil, fmt.Errorf("unable to get compute quota: %w", err)
+	}
+
+	l, err := computelimits.Get(ctx, client, computelimits.GetOpts{TenantID: projectID}).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get compute quota: %w", err)
+	}
+	return map[Resource]Usage{
+		Cores:     {Limit: l.Absolute.MaxTotalCores, InUse: l.Absolute.TotalCoresUsed},
+		RAM:       {Limit: l.Absolute.MaxTotalRAMSize, InUse: l.Absolute.TotalRAMUsed},
+		Instances: {Limit: l.Absolute.MaxTotalInstances, InUse: l.Absolute.TotalInstancesUsed},
+	}, nil
+}
+
+func collectNetwork(ctx context.Context, client *gophercloud.ServiceClient, projectID string) (map[Resource]Usage, error) {
+	q, err := networkquotas.GetDetail(ctx, client, projectID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get network quota: %w", err)
+	}
+	return map[Resource]Usage{
+		Ports:       {Limit: q.Port.Limit, InUse: q.Port.Used, Reserved: q.Port.Reserved},
+		FloatingIPs: {Limit: q.FloatingIP.Limit, InUse: q.FloatingIP.Used, Reserved: q.FloatingIP.Reserved},
+	}, nil
+}
+
+// collectBlockStorage uses the quota usage set, which includes reservations.
+// Unlike the compute limits, the block storage limits cannot be requested for
+// another project than the one of the token, so there is no fallback.
+func collectBlockStorage(ctx context.Context, client *gophercloud.ServiceClient, projectID string) (map[Resource]Usage, error) {
+	q, err := blockstoragequotasets.GetUsage(ctx, client, projectID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get block storage quota: %w", err)
+	}
+	return map[Resource]Usage{
+		Volumes:   {Limit: q.Volumes.Limit, InUse: q.Volumes.InUse, Reserved: q.Volumes.Reserved},
+		Gigabytes: {Limit: q.Gigabytes.Limit, InUse: q.Gigabytes.InUse, Reserved: q.Gigabytes.Reserved},
+	}, nil
+}
+
+// collectLoadBalancer counts the load balancers of the project, since the
+// Octavia quota API does not report usage.
+func collectLoadBalancer(ctx context.Context, client *gophercloud.ServiceClient, projectID string) (map[Resource]Usage, error) {
+	q, err := lbquotas.Get(ctx, client, projectID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get load balancer quota: %w", err)
+	}
+
+	var inUse int
+	err = loadbalancers.List(client, loadbalancers.ListOpts{ProjectID: projectID}).EachPage(ctx, func(_ context.Context, page pagination.Page) (bool, error) {
+		lbs, err := loadbalancers.ExtractLoadBalancers(page)
+		inUse += len(lbs)
+		return true, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to count load balancers: %w", err)
+	}
+
+	return map[Resource]Usage{
+		LoadBalancers: {Limit: q.Loadbalancer, InUse: inUse},
+	}, nil
+}
@@ -0,0 +1,2 @@
+// quota unit tests
+package testing
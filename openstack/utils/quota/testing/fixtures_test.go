@@ -0,0 +1,115 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const projectID = "6a9b4c3f0b2e4f3c9c1d8e7f6a5b4c3d"
+
+const ComputeQuotaDetailResponse = `
+{
+    "quota_set": {
+        "id": "6a9b4c3f0b2e4f3c9c1d8e7f6a5b4c3d",
+        "cores": {"in_use": 14, "limit": 20, "reserved": 2},
+        "ram": {"in_use": 16384, "limit": 51200, "reserved": 0},
+        "instances": {"in_use": 3, "limit": 10, "reserved": 0}
+    }
+}
+`
+
+const NetworkQuotaDetailResponse = `
+{
+    "quota": {
+        "port": {"used": 10, "limit": -1, "reserved": 0},
+        "floatingip": {"used": 4, "limit": 5, "reserved": 0}
+    }
+}
+`
+
+const BlockStorageQuotaUsageResponse = `
+{
+    "quota_set": {
+        "id": "6a9b4c3f0b2e4f3c9c1d8e7f6a5b4c3d",
+        "volumes": {"in_use": 2, "limit": 10, "reserved": 0, "allocated": 0},
+        "gigabytes": {"in_use": 200, "limit": 1000, "reserved": 0, "allocated": 0}
+    }
+}
+`
+
+const LoadBalancerQuotaResponse = `
+{
+    "quota": {
+        "loadbalancer": 2,
+        "listener": -1,
+        "member": -1,
+        "pool": -1,
+        "healthmonitor": -1,
+        "l7policy": -1,
+        "l7rule": -1
+    }
+}
+`
+
+const ListLoadBalancersResponse = `
+{
+    "loadbalancers": [
+        {"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", "name": "web"}
+    ]
+}
+`
+
+const ComputeLimitsResponse = `
+{
+    "limits": {
+        "absolute": {
+            "maxTotalCores": 20,
+            "maxTotalInstances": 10,
+            "maxTotalRAMSize": 51200,
+            "totalCoresUsed": 18,
+            "totalInstancesUsed": 5,
+            "totalRAMUsed": 16384
+        }
+    }
+}
+`
+
+func serviceClient(prefix string) *gophercloud.ServiceClient {
+	sc := fake.ServiceClient()
+	sc.ResourceBase = sc.Endpoint + prefix + "/"
+	return sc
+}
+
+func handle(t *testing.T, path, body string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, body)
+	})
+}
+
+func handleStatus(t *testing.T, path string, status int) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(status)
+	})
+}
+
+// HandleQuotasSuccessfully registers the quota endpoints of every service.
+func HandleQuotasSuccessfully(t *testing.T) {
+	handle(t, "/compute/os-quota-sets/"+projectID+"/detail", ComputeQuotaDetailResponse)
+	handle(t, "/network/quotas/"+projectID+"/details.json", NetworkQuotaDetailResponse)
+	handle(t, "/volume/os-quota-sets/"+projectID, BlockStorageQuotaUsageResponse)
+	handle(t, "/lb/quotas/"+projectID, LoadBalancerQuotaResponse)
+	handle(t, "/lb/lbaas/loadbalancers", ListLoadBalancersResponse)
+}
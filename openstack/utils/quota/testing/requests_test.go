@@ -0,0 +1,123 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils/quota"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func clients() quota.Clients {
+	return quota.Clients{
+		Compute:      serviceClient("compute"),
+		Network:      serviceClient("network"),
+		BlockStorage: serviceClient("volume"),
+		LoadBalancer: serviceClient("lb"),
+	}
+}
+
+func TestPreflightFits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleQuotasSuccessfully(t)
+
+	report, err := quota.Preflight(context.TODO(), clients(), quota.Demand{
+		ProjectID:     projectID,
+		Cores:         4,
+		RAM:           8192,
+		Instances:     2,
+		Ports:         100,
+		FloatingIPs:   1,
+		Volumes:       2,
+		Gigabytes:     100,
+		LoadBalancers: 1,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, report.Err())
+	th.AssertEquals(t, true, report.Fits())
+	th.AssertEquals(t, 8, len(report.Items))
+
+	th.AssertDeepEquals(t, quota.Item{
+		Resource:  quota.Cores,
+		Usage:     quota.Usage{Limit: 20, InUse: 14, Reserved: 2},
+		Requested: 4,
+		Headroom:  4,
+	}, report.Items[0])
+
+	th.AssertDeepEquals(t, quota.Item{
+		Resource:  quota.Ports,
+		Usage:     quota.Usage{Limit: -1, InUse: 10},
+		Requested: 100,
+		Headroom:  -1,
+	}, report.Items[3])
+}
+
+func TestPreflightShortfall(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleQuotasSuccessfully(t)
+
+	report, err := quota.Preflight(context.TODO(), clients(), quota.Demand{
+		ProjectID:     projectID,
+		Cores:         8,
+		FloatingIPs:   3,
+		LoadBalancers: 1,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, report.Fits())
+
+	shortfalls := report.Shortfalls()
+	th.AssertEquals(t, 2, len(shortfalls))
+	th.AssertEquals(t, quota.Cores, shortfalls[0].Resource)
+	th.AssertEquals(t, 4, shortfalls[0].Shortfall)
+	th.AssertEquals(t, quota.FloatingIPs, shortfalls[1].Resource)
+	th.AssertEquals(t, 2, shortfalls[1].Shortfall)
+
+	var quotaErr quota.ErrQuotaExceeded
+	th.AssertEquals(t, true, errors.As(report.Err(), &quotaErr))
+}
+
+func TestPreflightComputeLimitsFallback(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleStatus(t, "/compute/os-quota-sets/"+projectID+"/detail", http.StatusForbidden)
+	handle(t, "/compute/limits", ComputeLimitsResponse)
+
+	report, err := quota.Preflight(context.TODO(), quota.Clients{Compute: serviceClient("compute")}, quota.Demand{
+		ProjectID: projectID,
+		Cores:     4,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, quota.Cores, report.Items[0].Resource)
+	th.AssertDeepEquals(t, quota.Usage{Limit: 20, InUse: 18}, report.Items[0].Usage)
+	th.AssertEquals(t, 2, report.Items[0].Shortfall)
+}
+
+func TestPreflightComputeQuotaError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleStatus(t, "/compute/os-quota-sets/"+projectID+"/detail", http.StatusInternalServerError)
+	th.Mux.HandleFunc("/compute/limits", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected fallback to the absolute limits")
+	})
+
+	_, err := quota.Preflight(context.TODO(), quota.Clients{Compute: serviceClient("compute")}, quota.Demand{
+		ProjectID: projectID,
+		Cores:     4,
+	})
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusInternalServerError))
+}
+
+func TestPreflightMissingClient(t *testing.T) {
+	_, err := quota.Preflight(context.TODO(), quota.Clients{}, quota.Demand{
+		ProjectID: projectID,
+		Volumes:   1,
+	})
+	if err == nil {
+		t.Fatal("Expected an error for a demand without a block storage client")
+	}
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/pagination"
+)
+
+// ResolveID collects every page of pager, extracts its items with extract and
+// returns the ID of the single item whose name exactly matches name.
+//
+// The pager should already carry a server-side name filter where the API
+// supports one; matching is always repeated client-side so that APIs without
+// a name filter, or with a prefix or fuzzy match, still yield an exact result.
+// nameID returns the name and ID of an item.
+//
+// If no item matches, a gophercloud.ErrResourceNotFound is returned. If more
+// than one item matches, a gophercloud.ErrMultipleResourcesFound is returned.
+// In both cases resourceType is used to describe the resource in the error.
+func ResolveID[T any](ctx context.Context, pager pagination.Pager, extract func(pagination.Page) ([]T, error), nameID func(T) (string, string), resourceType, name string) (string, error) {
+	items, err := Collect(ctx, pager, extract)
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, item := range items {
+		if n, id := nameID(item); n == name {
+			ids = append(ids, id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", gophercloud.ErrResourceNotFound{Name: name, ResourceType: resourceType}
+	case 1:
+		return ids[0], nil
+	default:
+		return "", gophercloud.ErrMultipleResourcesFound{Name: name, Count: len(ids), ResourceType: resourceType}
+	}
+}
@@ -0,0 +1,89 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/vnpaycloud-console/gophercloud/v2/openstack/utils"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+	fake "github.com/vnpaycloud-console/gophercloud/v2/testhelper/client"
+)
+
+const resolveIDListResponse = `
+{
+    "networks": [
+        {
+            "id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "name": "public"
+        },
+        {
+            "id": "db193ab3-96e3-4cb3-8fc5-05f4296d0324",
+            "name": "private"
+        },
+        {
+            "id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c",
+            "name": "private"
+        },
+        {
+            "id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "name": "public-2"
+        }
+    ]
+}
+`
+
+func handleResolveIDList(t *testing.T) {
+	th.Mux.HandleFunc("/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, resolveIDListResponse)
+	})
+}
+
+func resolveNetworkID(client *gophercloud.ServiceClient, name string) (string, error) {
+	return utils.ResolveID(context.TODO(), networks.List(client, nil), networks.ExtractNetworks, func(n networks.Network) (string, string) {
+		return n.Name, n.ID
+	}, "network", name)
+}
+
+func TestResolveID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleResolveIDList(t)
+
+	id, err := resolveNetworkID(fake.ServiceClient(), "public")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "d32019d3-bc6e-4319-9c1d-6722fc136a22", id)
+}
+
+func TestResolveIDNotFound(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleResolveIDList(t)
+
+	_, err := resolveNetworkID(fake.ServiceClient(), "missing")
+	if _, ok := err.(gophercloud.ErrResourceNotFound); !ok {
+		t.Fatalf("Expected ErrResourceNotFound, got %v", err)
+	}
+}
+
+func TestResolveIDMultipleFound(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleResolveIDList(t)
+
+	_, err := resolveNetworkID(fake.ServiceClient(), "private")
+	e, ok := err.(gophercloud.ErrMultipleResourcesFound)
+	if !ok {
+		t.Fatalf("Expected ErrMultipleResourcesFound, got %v", err)
+	}
+	th.AssertEquals(t, 2, e.Count)
+}
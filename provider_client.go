@@ -97,6 +97,10 @@ type ProviderClient struct {
 	// to abort when an error is encountered.
 	RetryFunc RetryFunc
 
+	// Recorder, if set, captures mutating requests instead of sending them and
+	// returns synthetic successful responses. See RequestRecorder.
+	Recorder *RequestRecorder
+
 	// mut is a mutex for the client. It protects read and write access to client attributes such as getting
 	// and setting the TokenID.
 	mut *sync.RWMutex
@@ -356,6 +360,7 @@ func (client *ProviderClient) Request(ctx context.Context, method, url string, o
 func (client *ProviderClient) doRequest(ctx context.Context, method, url string, options *RequestOpts, state *requestState) (*http.Response, error) {
 	var body io.Reader
 	var contentType *string
+	var rendered []byte
 
 	// Derive the content body by either encoding an arbitrary object as JSON, or by taking a provided
 	// io.ReadSeeker as-is. Default the content-type to application/json.
@@ -364,7 +369,8 @@ func (client *ProviderClient) doRequest(ctx context.Context, method, url string,
 			return nil, errors.New("please provide only one of JSONBody or RawBody to gophercloud.Request()")
 		}
 
-		rendered, err := json.Marshal(options.JSONBody)
+		var err error
+		rendered, err = json.Marshal(options.JSONBody)
 		if err != nil {
 			return nil, err
 		}
@@ -413,6 +419,15 @@ func (client *ProviderClient) doRequest(ctx context.Context, method, url string,
 		req.Header.Set(k, v)
 	}
 
+	// Capture the request instead of sending it, if recording is enabled.
+	if client.Recorder != nil && client.Recorder.captures(method) && !client.IsThrowaway() {
+		okc := options.OkCodes
+		if okc == nil {
+			okc = defaultOkCodes(method)
+		}
+		return client.Recorder.capture(req, rendered, okc)
+	}
+
 	prereqtok := req.Header.Get("X-Auth-Token")
 
 	// Issue the request.
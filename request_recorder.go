@@ -0,0 +1,203 @@
+package gophercloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// RedactedValue replaces the value of sensitive headers in captured requests.
+const RedactedValue = "<redacted>"
+
+// sensitiveHeaders are always redacted from captured requests.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"X-Auth-Token",
+	"X-Subject-Token",
+	"X-Service-Token",
+	"X-Auth-Key",
+	"X-Auth-User",
+	"X-Account-Meta-Temp-URL-Key",
+	"X-Account-Meta-Temp-URL-Key-2",
+	"X-Container-Meta-Temp-URL-Key",
+	"X-Container-Meta-Temp-URL-Key-2",
+}
+
+// sensitiveFields are the JSON body fields whose string values are always
+// redacted from captured requests, at any depth.
+var sensitiveFields = []string{
+	"adminPass",
+	"password",
+	"original_password",
+	"new_password",
+	"passcode",
+	"secret",
+}
+
+// CapturedRequest is a request recorded by a RequestRecorder instead of
+// being sent.
+type CapturedRequest struct {
+	Method string
+	URL    string
+
+	// Header holds the request headers, with sensitive values replaced by
+	// RedactedValue.
+	Header http.Header
+
+	// Body is the JSON body of the request, if it has one, with the string
+	// values of sensitive fields such as passwords and application
+	// credential secrets replaced by RedactedValue.
+	Body json.RawMessage
+
+	// RawBody is the body of the request when it is not JSON, e.g. an object
+	// uploaded to Swift. It is recorded as is, without any redaction.
+	RawBody []byte
+}
+
+// RequestRecorder captures mutating requests (POST, PUT, PATCH and DELETE)
+// made through a ProviderClient instead of sending them, so that the calls an
+// operation would make can be reviewed before it is run for real.
+//
+// Captured requests return a synthetic successful response with the first
+// status code the caller accepts and an empty body. Code that relies on the
+// content of a response, such as the ID of a created resource, will see zero
+// values.
+//
+// Set it on ProviderClient.Recorder once the client is authenticated;
+// reauthentication requests are never captured.
+type RequestRecorder struct {
+	// CaptureReads also captures GET and HEAD requests. By default they are
+	// sent, so that lookups still return live data.
+	CaptureReads bool
+
+	// RedactHeaders lists additional headers whose value is redacted.
+	RedactHeaders []string
+
+	// RedactFields lists additional JSON body fields whose string values
+	// are redacted.
+	RedactFields []string
+
+	mu       sync.Mutex
+	requests []CapturedRequest
+}
+
+// Requests returns the requests captured so far, in the order they were made.
+func (r *RequestRecorder) Requests() []CapturedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]CapturedRequest(nil), r.requests...)
+}
+
+// Reset discards the captured requests.
+func (r *RequestRecorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = nil
+}
+
+// captures reports whether a request with the given method is captured.
+func (r *RequestRecorder) captures(method string) bool {
+	switch method {
+	case "GET", "HEAD":
+		return r.CaptureReads
+	}
+	return true
+}
+
+// capture records req and returns a synthetic response to it.
+func (r *RequestRecorder) capture(req *http.Request, jsonBody []byte, okCodes []int) (*http.Response, error) {
+	c := CapturedRequest{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Header: req.Header.Clone(),
+	}
+
+	headers := make([]string, 0, len(sensitiveHeaders)+len(r.RedactHeaders))
+	headers = append(append(headers, sensitiveHeaders...), r.RedactHeaders...)
+	for _, h := range headers {
+		if c.Header.Get(h) != "" {
+			c.Header.Set(h, RedactedValue)
+		}
+	}
+
+	if jsonBody != nil {
+		c.Body = r.redactBody(jsonBody)
+	} else if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		c.RawBody = b
+	}
+
+	r.mu.Lock()
+	r.requests = append(r.requests, c)
+	r.mu.Unlock()
+
+	status := http.StatusOK
+	if len(okCodes) > 0 {
+		status = okCodes[0]
+	}
+
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode: status,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}, nil
+}
+
+// redactBody returns a JSON body with the string values of sensitive fields
+// replaced by RedactedValue. The body is returned unchanged if it holds none.
+func (r *RequestRecorder) redactBody(body []byte) json.RawMessage {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return json.RawMessage(body)
+	}
+
+	fields := make(map[string]bool, len(sensitiveFields)+len(r.RedactFields))
+	for _, f := range sensitiveFields {
+		fields[f] = true
+	}
+	for _, f := range r.RedactFields {
+		fields[f] = true
+	}
+
+	if !redactFields(v, fields) {
+		return json.RawMessage(body)
+	}
+	redacted, err := json.Marshal(v)
+	if err != nil {
+		return json.RawMessage(body)
+	}
+	return json.RawMessage(redacted)
+}
+
+// redactFields replaces the string values of the given fields in a decoded
+// JSON value, and reports whether any was replaced.
+func redactFields(v any, fields map[string]bool) bool {
+	var redacted bool
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if _, ok := e.(string); ok && fields[k] {
+				v[k] = RedactedValue
+				redacted = true
+				continue
+			}
+			redacted = redactFields(e, fields) || redacted
+		}
+	case []any:
+		for _, e := range v {
+			redacted = redactFields(e, fields) || redacted
+		}
+	}
+	return redacted
+}
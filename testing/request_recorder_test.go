@@ -0,0 +1,118 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/vnpaycloud-console/gophercloud/v2"
+	th "github.com/vnpaycloud-console/gophercloud/v2/testhelper"
+)
+
+func TestRequestRecorderCapturesMutatingRequests(t *testing.T) {
+	var sent int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&sent, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"server": {"id": "live"}}`)
+	}))
+	defer ts.Close()
+
+	recorder := &gophercloud.RequestRecorder{RedactHeaders: []string{"X-Secret"}}
+	p := &gophercloud.ProviderClient{TokenID: "s3cr3t", Recorder: recorder}
+
+	var actual struct {
+		Server struct {
+			ID string `json:"id"`
+		} `json:"server"`
+	}
+	resp, err := p.Request(context.TODO(), "POST", ts.URL+"/servers", &gophercloud.RequestOpts{
+		JSONBody:     map[string]any{"server": map[string]string{"name": "web"}},
+		JSONResponse: &actual,
+		OkCodes:      []int{202},
+		MoreHeaders:  map[string]string{"X-Secret": "hunter2", "X-Other": "visible"},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 202, resp.StatusCode)
+	th.AssertEquals(t, "", actual.Server.ID)
+
+	_, err = p.Request(context.TODO(), "DELETE", ts.URL+"/servers/1", &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, int32(0), atomic.LoadInt32(&sent))
+
+	requests := recorder.Requests()
+	th.AssertEquals(t, 2, len(requests))
+
+	th.AssertEquals(t, "POST", requests[0].Method)
+	th.AssertEquals(t, ts.URL+"/servers", requests[0].URL)
+	th.AssertEquals(t, gophercloud.RedactedValue, requests[0].Header.Get("X-Auth-Token"))
+	th.AssertEquals(t, gophercloud.RedactedValue, requests[0].Header.Get("X-Secret"))
+	th.AssertEquals(t, "visible", requests[0].Header.Get("X-Other"))
+	th.AssertJSONEquals(t, `{"server": {"name": "web"}}`, requests[0].Body)
+
+	th.AssertEquals(t, "DELETE", requests[1].Method)
+	th.AssertEquals(t, 0, len(requests[1].Body))
+
+	recorder.Reset()
+	th.AssertEquals(t, 0, len(recorder.Requests()))
+}
+
+func TestRequestRecorderReads(t *testing.T) {
+	var sent int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&sent, 1)
+		fmt.Fprint(w, "OK")
+	}))
+	defer ts.Close()
+
+	recorder := &gophercloud.RequestRecorder{}
+	p := &gophercloud.ProviderClient{Recorder: recorder}
+
+	_, err := p.Request(context.TODO(), "GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int32(1), atomic.LoadInt32(&sent))
+	th.AssertEquals(t, 0, len(recorder.Requests()))
+
+	recorder.CaptureReads = true
+	_, err = p.Request(context.TODO(), "GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int32(1), atomic.LoadInt32(&sent))
+	th.AssertEquals(t, 1, len(recorder.Requests()))
+}
+
+func TestRequestRecorderRedactsBody(t *testing.T) {
+	recorder := &gophercloud.RequestRecorder{RedactFields: []string{"user_data"}}
+	p := &gophercloud.ProviderClient{Recorder: recorder}
+
+	resp, err := p.Request(context.TODO(), "POST", "http://example.com/servers", &gophercloud.RequestOpts{
+		JSONBody: map[string]any{
+			"server": map[string]any{
+				"name":      "web",
+				"adminPass": "hunter2",
+				"user_data": "c2VjcmV0",
+			},
+		},
+		OkCodes: []int{202},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "202 Accepted", resp.Status)
+
+	_, err = p.Request(context.TODO(), "POST", "http://example.com/application_credentials", &gophercloud.RequestOpts{
+		JSONBody: map[string]any{
+			"application_credential": map[string]any{
+				"name":   "ci",
+				"secret": "s3cr3t",
+				"roles":  []map[string]string{{"name": "member"}},
+			},
+		},
+	})
+	th.AssertNoErr(t, err)
+
+	requests := recorder.Requests()
+	th.AssertJSONEquals(t, `{"server": {"name": "web", "adminPass": "<redacted>", "user_data": "<redacted>"}}`, requests[0].Body)
+	th.AssertJSONEquals(t, `{"application_credential": {"name": "ci", "secret": "<redacted>", "roles": [{"name": "member"}]}}`, requests[1].Body)
+}